@@ -0,0 +1,89 @@
+// Package openrpc builds machine-readable API schemas (OpenAPI 3.1 and
+// OpenRPC 1.2.6) from the routes package, following the same
+// "generate-the-schema-from-the-handler-set" approach Lotus uses for its
+// JSON-RPC surface.
+package openrpc
+
+import (
+	"encoding/json"
+	"rwa-backend/api/routes"
+	"strings"
+)
+
+const (
+	serviceName    = "EVERA RWA Backend"
+	serviceVersion = "1.0.0"
+)
+
+// GenerateOpenAPI produces an OpenAPI 3.1 document describing every REST
+// route in routes.All.
+func GenerateOpenAPI() ([]byte, error) {
+	paths := map[string]map[string]interface{}{}
+
+	for _, route := range routes.All {
+		path := toOpenAPIPath(route.Path)
+		methods, ok := paths[path]
+		if !ok {
+			methods = map[string]interface{}{}
+			paths[path] = methods
+		}
+		methods[strings.ToLower(route.Method)] = map[string]interface{}{
+			"operationId": route.RPCMethod,
+			"summary":     route.Summary,
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{"description": "Success"},
+			},
+		}
+	}
+
+	doc := map[string]interface{}{
+		"openapi": "3.1.0",
+		"info": map[string]interface{}{
+			"title":   serviceName,
+			"version": serviceVersion,
+		},
+		"paths": paths,
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// GenerateOpenRPC produces an OpenRPC 1.2.6 document mirroring the same
+// routes, one method per route, named after its RPCMethod.
+func GenerateOpenRPC() ([]byte, error) {
+	methods := make([]map[string]interface{}, 0, len(routes.All))
+	for _, route := range routes.All {
+		methods = append(methods, map[string]interface{}{
+			"name":    route.RPCMethod,
+			"summary": route.Summary,
+			"params":  []interface{}{},
+			"result": map[string]interface{}{
+				"name":   route.RPCMethod + "Result",
+				"schema": map[string]interface{}{"type": "object"},
+			},
+		})
+	}
+
+	doc := map[string]interface{}{
+		"openrpc": "1.2.6",
+		"info": map[string]interface{}{
+			"title":   serviceName,
+			"version": serviceVersion,
+		},
+		"methods": methods,
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// toOpenAPIPath rewrites gin's ":id" path parameters into OpenAPI's
+// "{id}" form.
+func toOpenAPIPath(ginPath string) string {
+	segments := strings.Split(ginPath, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
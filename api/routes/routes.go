@@ -0,0 +1,44 @@
+// Package routes is the single declarative table of every HTTP endpoint
+// this service exposes. docsgen walks it to emit OpenAPI/OpenRPC schemas,
+// and the JSON-RPC dispatcher (handlers.NewRPCHandler) walks it to map an
+// "RWA.Method" call onto the matching REST route. It intentionally holds
+// no reference to the handlers package so it can be imported from both
+// sides without a cycle; main.go's setupRoutes is the source of truth for
+// what's actually registered, and this table must be kept in sync with it
+// by hand when a route is added, removed, or moved.
+package routes
+
+// Route describes one REST endpoint in terms a schema generator or an
+// RPC dispatcher can act on without touching gin directly.
+type Route struct {
+	Method    string // HTTP method, e.g. "GET"
+	Path      string // gin-style path, e.g. "/api/assets/:id"
+	RPCMethod string // JSON-RPC 2.0 method name, e.g. "RWA.GetAsset"
+	Summary   string
+}
+
+// All is every route this service serves, in registration order.
+var All = []Route{
+	{Method: "GET", Path: "/api/health", RPCMethod: "RWA.HealthCheck", Summary: "Check API and database health"},
+	{Method: "GET", Path: "/api/assets", RPCMethod: "RWA.GetAllAssets", Summary: "List assets, optionally filtered by type/minYield/institution"},
+	{Method: "POST", Path: "/api/assets/mint", RPCMethod: "RWA.MintAsset", Summary: "Mint a new tokenized real-world asset"},
+	{Method: "GET", Path: "/api/assets/:id", RPCMethod: "RWA.GetAsset", Summary: "Fetch a single asset by ID"},
+	{Method: "PATCH", Path: "/api/assets/:id/contract", RPCMethod: "RWA.UpdateContractAddress", Summary: "Record an asset's deployed contract address"},
+	{Method: "GET", Path: "/api/assets/:id/stats", RPCMethod: "RWA.GetAssetStats", Summary: "Fetch on-chain token statistics for an asset"},
+	{Method: "PATCH", Path: "/api/assets/:id/staking", RPCMethod: "RWA.UpdateStaking", Summary: "Update an asset's staked amount"},
+	{Method: "POST", Path: "/api/assets/:id/reindex", RPCMethod: "RWA.ReindexAsset", Summary: "Force a full indexer rescan for an asset's contract"},
+	{Method: "POST", Path: "/api/assets/:id/halt", RPCMethod: "RWA.HaltAsset", Summary: "Schedule a governance halt on an asset"},
+	{Method: "POST", Path: "/api/assets/:id/halt/lift", RPCMethod: "RWA.LiftAssetHalt", Summary: "Lift an active governance halt"},
+	{Method: "GET", Path: "/api/mint-jobs/:id", RPCMethod: "RWA.GetMintJob", Summary: "Poll the status of an asynchronous mint job"},
+	{Method: "POST", Path: "/api/mint-jobs/:id/retry", RPCMethod: "RWA.RetryMintJob", Summary: "Retry a failed mint job"},
+}
+
+// ByRPCMethod looks up a Route by its JSON-RPC method name.
+func ByRPCMethod(name string) (Route, bool) {
+	for _, route := range All {
+		if route.RPCMethod == name {
+			return route, true
+		}
+	}
+	return Route{}, false
+}
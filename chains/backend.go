@@ -0,0 +1,98 @@
+// Package chains abstracts the operations the API layer needs from a
+// deployed token contract behind a single interface, so the same asset can
+// be minted on Lisk, Ethereum or a local devnet without the handlers
+// knowing which one they're talking to.
+package chains
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// DeployRequest carries everything a backend needs to deploy a new token
+// contract for an asset.
+type DeployRequest struct {
+	AssetID     string
+	Name        string
+	Symbol      string
+	TotalSupply *big.Int
+}
+
+// DeployResult is what a backend returns after broadcasting a deployment.
+type DeployResult struct {
+	ContractAddress string
+	TxHash          string
+	DeployBlock     uint64
+	ABIVersion      string
+}
+
+// HolderBalance is a single address/balance pair returned by Holders.
+type HolderBalance struct {
+	Address string
+	Balance *big.Int
+}
+
+// TokenBackend is implemented once per chain. GetAssetStats, MintAsset and
+// UpdateContractAddress all route through it instead of assuming Lisk.
+//
+// TotalSupply, BalanceOf, Transfer and Stake all take the calling
+// Deployment's abiVersion so a backend can pick the matching decoder
+// instead of assuming every Deployment for a contract was compiled
+// against the same ABI; see evmBackend.abiFor for the upgrade path an
+// unknown or unset version falls back through.
+type TokenBackend interface {
+	// Deploy broadcasts a new token contract and returns its address once
+	// submitted (confirmation is tracked separately, see the mintpool
+	// package).
+	Deploy(ctx context.Context, req DeployRequest) (DeployResult, error)
+	TotalSupply(ctx context.Context, contractAddress, abiVersion string) (*big.Int, error)
+	BalanceOf(ctx context.Context, contractAddress, holder, abiVersion string) (*big.Int, error)
+	Transfer(ctx context.Context, contractAddress, from, to string, amount *big.Int, abiVersion string) (txHash string, err error)
+	Stake(ctx context.Context, contractAddress, staker string, amount *big.Int, abiVersion string) (txHash string, err error)
+	// Holders takes the Deployment's recorded deployBlock so a backend that
+	// delegates to the indexer package (see evmBackend.Holders) scans from
+	// the contract's actual deployment instead of genesis.
+	Holders(ctx context.Context, contractAddress string, deployBlock uint64) ([]HolderBalance, error)
+}
+
+// Confirmer is optionally implemented by a TokenBackend that can report
+// how many confirmations a broadcast transaction has received. mintpool
+// type-asserts for it after Deploy; a backend that doesn't implement it
+// (e.g. the in-memory devnet, which has no concept of block depth) is
+// treated as confirming its transactions immediately.
+type Confirmer interface {
+	// Confirmations returns the block the transaction was mined in and
+	// how many blocks have been built on top of it. It returns an error
+	// while the transaction is still pending (not yet mined).
+	Confirmations(ctx context.Context, txHash string) (blockNumber, confirmations uint64, err error)
+}
+
+// key identifies a backend by chain name and network version, e.g.
+// ("Lisk", "mainnet") vs ("Lisk", "testnet").
+type key struct {
+	blockchain     string
+	networkVersion string
+}
+
+var registry = map[key]TokenBackend{}
+
+// Register installs a backend for blockchain/networkVersion. Called from
+// each backend's init() so selecting a backend never requires a switch
+// statement to grow.
+func Register(blockchain, networkVersion string, backend TokenBackend) {
+	registry[key{blockchain, networkVersion}] = backend
+}
+
+// Get returns the backend registered for blockchain/networkVersion. An
+// empty networkVersion falls back to "mainnet", matching Asset's default.
+func Get(blockchain, networkVersion string) (TokenBackend, error) {
+	if networkVersion == "" {
+		networkVersion = "mainnet"
+	}
+	backend, ok := registry[key{blockchain, networkVersion}]
+	if !ok {
+		return nil, fmt.Errorf("no token backend registered for %s/%s", blockchain, networkVersion)
+	}
+	return backend, nil
+}
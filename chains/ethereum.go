@@ -0,0 +1,8 @@
+package chains
+
+// Ethereum registers the Ethereum backend, mainnet and Sepolia, so an
+// asset can mint there in addition to Lisk.
+func init() {
+	Register("Ethereum", "mainnet", newEVMBackend("Ethereum", "ETH_RPC_URL", "ETH_DEPLOYER_KEY", "ETH_TOKEN_BYTECODE", "v1"))
+	Register("Ethereum", "sepolia", newEVMBackend("Ethereum", "ETH_SEPOLIA_RPC_URL", "ETH_DEPLOYER_KEY", "ETH_TOKEN_BYTECODE", "v1"))
+}
@@ -0,0 +1,275 @@
+package chains
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"rwa-backend/indexer"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+func eCallMsg(to common.Address, data []byte) ethereum.CallMsg {
+	return ethereum.CallMsg{To: &to, Data: data}
+}
+
+// erc20ABIV1 covers the handful of methods every backend here actually
+// calls; it's hand-rolled rather than generated from a full ABI file since
+// no contract artifact ships in this repo yet.
+const erc20ABIV1 = `[
+	{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"type":"function"},
+	{"constant":true,"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"type":"function"},
+	{"constant":false,"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"type":"function"},
+	{"constant":false,"inputs":[{"name":"amount","type":"uint256"}],"name":"stake","outputs":[],"type":"function"}
+]`
+
+// abiJSONByVersion maps a Deployment's ABIVersion to the ABI it was
+// deployed against. This is the upgrade path for a contract ABI change:
+// add the new version's JSON here, and evmBackend.abiFor picks it per
+// call instead of assuming every Deployment used the same ABI. Versions
+// not listed here (including "", from rows recorded before ABI
+// versioning existed) fall back to "v1" rather than failing the call.
+var abiJSONByVersion = map[string]string{
+	"v1": erc20ABIV1,
+}
+
+// evmBackend implements TokenBackend for any EVM-compatible chain (Lisk L2
+// and Ethereum both run through this); only the RPC endpoint and signing
+// key differ between them.
+type evmBackend struct {
+	chainName   string
+	rpcURLEnv   string
+	deployerEnv string
+	bytecodeEnv string
+	abiVersion  string
+	abis        map[string]abi.ABI
+}
+
+func newEVMBackend(chainName, rpcURLEnv, deployerEnv, bytecodeEnv, abiVersion string) *evmBackend {
+	abis := make(map[string]abi.ABI, len(abiJSONByVersion))
+	for version, source := range abiJSONByVersion {
+		parsed, err := abi.JSON(strings.NewReader(source))
+		if err != nil {
+			panic(fmt.Sprintf("chains: invalid embedded ABI %q: %v", version, err))
+		}
+		abis[version] = parsed
+	}
+	return &evmBackend{
+		chainName:   chainName,
+		rpcURLEnv:   rpcURLEnv,
+		deployerEnv: deployerEnv,
+		bytecodeEnv: bytecodeEnv,
+		abiVersion:  abiVersion,
+		abis:        abis,
+	}
+}
+
+// abiFor resolves the parsed ABI for version, falling back to v1 so an
+// unknown or unset version never fails decoding outright.
+func (b *evmBackend) abiFor(version string) abi.ABI {
+	if parsed, ok := b.abis[version]; ok {
+		return parsed
+	}
+	return b.abis["v1"]
+}
+
+func (b *evmBackend) dial() (*ethclient.Client, error) {
+	rpcURL := os.Getenv(b.rpcURLEnv)
+	if rpcURL == "" {
+		return nil, fmt.Errorf("%s is not configured", b.rpcURLEnv)
+	}
+	return ethclient.Dial(rpcURL)
+}
+
+func (b *evmBackend) signer() (*ecdsa.PrivateKey, common.Address, error) {
+	hexKey := os.Getenv(b.deployerEnv)
+	if hexKey == "" {
+		return nil, common.Address{}, fmt.Errorf("%s is not configured", b.deployerEnv)
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("parse deployer key: %w", err)
+	}
+	return key, crypto.PubkeyToAddress(key.PublicKey), nil
+}
+
+func (b *evmBackend) Deploy(ctx context.Context, req DeployRequest) (DeployResult, error) {
+	bytecode := os.Getenv(b.bytecodeEnv)
+	if bytecode == "" {
+		return DeployResult{}, fmt.Errorf("%s is not configured, cannot deploy %s on %s", b.bytecodeEnv, req.Symbol, b.chainName)
+	}
+
+	client, err := b.dial()
+	if err != nil {
+		return DeployResult{}, err
+	}
+	key, from, err := b.signer()
+	if err != nil {
+		return DeployResult{}, err
+	}
+
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return DeployResult{}, fmt.Errorf("fetch nonce: %w", err)
+	}
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return DeployResult{}, fmt.Errorf("suggest gas price: %w", err)
+	}
+	chainID, err := client.NetworkID(ctx)
+	if err != nil {
+		return DeployResult{}, fmt.Errorf("fetch chain id: %w", err)
+	}
+
+	tx := types.NewContractCreation(nonce, big.NewInt(0), 3_000_000, gasPrice, common.FromHex(bytecode))
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), key)
+	if err != nil {
+		return DeployResult{}, fmt.Errorf("sign deploy tx: %w", err)
+	}
+	if err := client.SendTransaction(ctx, signedTx); err != nil {
+		return DeployResult{}, fmt.Errorf("broadcast deploy tx: %w", err)
+	}
+
+	return DeployResult{
+		ContractAddress: crypto.CreateAddress(from, nonce).Hex(),
+		TxHash:          signedTx.Hash().Hex(),
+		ABIVersion:      b.abiVersion,
+	}, nil
+}
+
+func (b *evmBackend) TotalSupply(ctx context.Context, contractAddress, abiVersion string) (*big.Int, error) {
+	return b.callUint256(ctx, contractAddress, abiVersion, "totalSupply")
+}
+
+func (b *evmBackend) BalanceOf(ctx context.Context, contractAddress, holder, abiVersion string) (*big.Int, error) {
+	return b.callUint256(ctx, contractAddress, abiVersion, "balanceOf", common.HexToAddress(holder))
+}
+
+func (b *evmBackend) callUint256(ctx context.Context, contractAddress, abiVersion, method string, args ...interface{}) (*big.Int, error) {
+	client, err := b.dial()
+	if err != nil {
+		return nil, err
+	}
+	parsedABI := b.abiFor(abiVersion)
+	data, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack %s: %w", method, err)
+	}
+	to := common.HexToAddress(contractAddress)
+	result, err := client.CallContract(ctx, eCallMsg(to, data), nil)
+	if err != nil {
+		return nil, fmt.Errorf("call %s: %w", method, err)
+	}
+	out, err := parsedABI.Unpack(method, result)
+	if err != nil || len(out) == 0 {
+		return nil, fmt.Errorf("unpack %s: %w", method, err)
+	}
+	value, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s return type", method)
+	}
+	return value, nil
+}
+
+func (b *evmBackend) Transfer(ctx context.Context, contractAddress, from, to string, amount *big.Int, abiVersion string) (string, error) {
+	return b.sendMethod(ctx, contractAddress, abiVersion, "transfer", common.HexToAddress(to), amount)
+}
+
+func (b *evmBackend) Stake(ctx context.Context, contractAddress, staker string, amount *big.Int, abiVersion string) (string, error) {
+	return b.sendMethod(ctx, contractAddress, abiVersion, "stake", amount)
+}
+
+func (b *evmBackend) sendMethod(ctx context.Context, contractAddress, abiVersion, method string, args ...interface{}) (string, error) {
+	client, err := b.dial()
+	if err != nil {
+		return "", err
+	}
+	key, from, err := b.signer()
+	if err != nil {
+		return "", err
+	}
+	data, err := b.abiFor(abiVersion).Pack(method, args...)
+	if err != nil {
+		return "", fmt.Errorf("pack %s: %w", method, err)
+	}
+
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return "", fmt.Errorf("fetch nonce: %w", err)
+	}
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return "", fmt.Errorf("suggest gas price: %w", err)
+	}
+	chainID, err := client.NetworkID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("fetch chain id: %w", err)
+	}
+
+	to := common.HexToAddress(contractAddress)
+	tx := types.NewTransaction(nonce, to, big.NewInt(0), 200_000, gasPrice, data)
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), key)
+	if err != nil {
+		return "", fmt.Errorf("sign %s tx: %w", method, err)
+	}
+	if err := client.SendTransaction(ctx, signedTx); err != nil {
+		return "", fmt.Errorf("broadcast %s tx: %w", method, err)
+	}
+	return signedTx.Hash().Hex(), nil
+}
+
+// Confirmations implements chains.Confirmer by fetching the transaction's
+// receipt and comparing its block against the current chain head.
+func (b *evmBackend) Confirmations(ctx context.Context, txHash string) (uint64, uint64, error) {
+	client, err := b.dial()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if err != nil {
+		return 0, 0, fmt.Errorf("transaction not yet mined: %w", err)
+	}
+
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("fetch chain head: %w", err)
+	}
+
+	blockNumber := receipt.BlockNumber.Uint64()
+	if head < blockNumber {
+		return blockNumber, 0, nil
+	}
+	return blockNumber, head - blockNumber, nil
+}
+
+// Holders defers to the indexer package, which already tracks per-holder
+// balances from Transfer logs for any contract it follows.
+func (b *evmBackend) Holders(ctx context.Context, contractAddress string, deployBlock uint64) ([]HolderBalance, error) {
+	ix, err := indexer.GetManager().For(contractAddress, deployBlock)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := ix.Holders()
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]HolderBalance, 0, len(rows))
+	for _, row := range rows {
+		balance, ok := new(big.Int).SetString(row.Balance, 10)
+		if !ok {
+			balance = big.NewInt(0)
+		}
+		holders = append(holders, HolderBalance{Address: row.Address, Balance: balance})
+	}
+	return holders, nil
+}
@@ -0,0 +1,9 @@
+package chains
+
+// Lisk registers the Lisk L2 backend for both supported network versions.
+// It shares its implementation with ethereum.go since Lisk L2 is
+// EVM-equivalent; only the configured RPC endpoint and signing key differ.
+func init() {
+	Register("Lisk", "mainnet", newEVMBackend("Lisk", "LISK_RPC_URL", "LISK_DEPLOYER_KEY", "LISK_TOKEN_BYTECODE", "v1"))
+	Register("Lisk", "testnet", newEVMBackend("Lisk", "LISK_TESTNET_RPC_URL", "LISK_DEPLOYER_KEY", "LISK_TOKEN_BYTECODE", "v1"))
+}
@@ -0,0 +1,107 @@
+// Command docsgen regenerates the OpenAPI and OpenRPC schemas from
+// api/routes and writes them, gzip-compressed, under build/openrpc/.
+// Run with -check in CI to fail the build if the committed schemas have
+// drifted from what the route table currently produces.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"rwa-backend/api/openrpc"
+)
+
+const outputDir = "build/openrpc"
+
+func main() {
+	check := flag.Bool("check", false, "verify committed schemas match the regenerated output instead of writing them")
+	flag.Parse()
+
+	openapiDoc, err := openrpc.GenerateOpenAPI()
+	if err != nil {
+		log.Fatalf("generate OpenAPI schema: %v", err)
+	}
+	openrpcDoc, err := openrpc.GenerateOpenRPC()
+	if err != nil {
+		log.Fatalf("generate OpenRPC schema: %v", err)
+	}
+
+	files := map[string][]byte{
+		filepath.Join(outputDir, "openapi.json.gz"): openapiDoc,
+		filepath.Join(outputDir, "openrpc.json.gz"): openrpcDoc,
+	}
+
+	if *check {
+		drifted := false
+		for path, want := range files {
+			if err := checkFile(path, want); err != nil {
+				fmt.Fprintf(os.Stderr, "drift in %s: %v\n", path, err)
+				drifted = true
+			}
+		}
+		if drifted {
+			os.Exit(1)
+		}
+		fmt.Println("schemas are up to date")
+		return
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		log.Fatalf("create %s: %v", outputDir, err)
+	}
+	for path, doc := range files {
+		if err := writeGzip(path, doc); err != nil {
+			log.Fatalf("write %s: %v", path, err)
+		}
+	}
+	fmt.Printf("wrote %s\n", outputDir)
+}
+
+func writeGzip(path string, data []byte) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	if _, err := gz.Write(data); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func checkFile(path string, want []byte) error {
+	existing, err := readGzip(path)
+	if err != nil {
+		return fmt.Errorf("read committed schema: %w", err)
+	}
+	if !bytes.Equal(existing, want) {
+		return fmt.Errorf("committed schema does not match regenerated output, run `go run ./cmd/docsgen`")
+	}
+	return nil
+}
+
+func readGzip(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(gz); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"log"
+	"os"
 	"rwa-backend/models"
 
 	"gorm.io/driver/sqlite"
@@ -11,7 +12,14 @@ import (
 
 var DB *gorm.DB
 
-// InitDatabase initializes the SQLite database connection and runs migrations
+// InitDatabase initializes the SQLite database connection, runs
+// migrations, and selects the asset Repository per DB_DRIVER.
+//
+// The indexer and chains packages track on-chain bookkeeping (Holder,
+// StakeEvent, Transfer, Deployment, IndexerCursor) straight through this
+// SQLite connection regardless of DB_DRIVER; only asset CRUD/querying
+// moves to CouchDB when DB_DRIVER=couchdb, since that's the data a
+// Fabric-style deployment wants alongside its chaincode.
 func InitDatabase() {
 	var err error
 
@@ -25,12 +33,34 @@ func InitDatabase() {
 	}
 
 	// Auto migrate the schema
-	err = DB.AutoMigrate(&models.Asset{})
+	err = DB.AutoMigrate(
+		&models.Asset{},
+		&models.Deployment{},
+		&models.Holder{},
+		&models.StakeEvent{},
+		&models.Transfer{},
+		&models.IndexerCursor{},
+		&models.AssetHalt{},
+		&models.MintJob{},
+	)
 	if err != nil {
 		log.Fatal("Failed to migrate database:", err)
 	}
 
 	log.Println("✅ Database connected and migrated successfully with pure Go SQLite driver")
+
+	repo, err = NewRepository()
+	if err != nil {
+		log.Fatal("Failed to initialize asset repository:", err)
+	}
+	log.Printf("✅ Asset repository ready (driver=%s)", driverName())
+}
+
+func driverName() string {
+	if name := os.Getenv("DB_DRIVER"); name != "" {
+		return name
+	}
+	return "sqlite"
 }
 
 // GetDB returns the database instance
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"rwa-backend/models"
+)
+
+// AssetFilter narrows ListAssets/QueryAssets results. Zero values mean "no
+// filter on this field", so GET /api/assets with no query params behaves
+// exactly like before.
+type AssetFilter struct {
+	Type        string
+	MinYield    float64
+	Institution string
+}
+
+// Repository is the storage-agnostic surface the handlers use for asset
+// persistence. It has two implementations: sqliteRepository (the original
+// GORM/SQLite path) and couchDBRepository, selected by DB_DRIVER so an
+// operator can point this backend at a CouchDB instance already running
+// alongside a Hyperledger Fabric chaincode instead of standing up a
+// separate SQL database.
+type Repository interface {
+	CreateAsset(ctx context.Context, asset *models.Asset) error
+	GetAsset(ctx context.Context, id string) (*models.Asset, error)
+	ListAssets(ctx context.Context) ([]models.Asset, error)
+	UpdateAsset(ctx context.Context, asset *models.Asset) error
+	QueryAssets(ctx context.Context, filter AssetFilter) ([]models.Asset, error)
+}
+
+var repo Repository
+
+// NewRepository selects and constructs a Repository based on the
+// DB_DRIVER environment variable ("sqlite", the default, or "couchdb").
+func NewRepository() (Repository, error) {
+	switch driver := os.Getenv("DB_DRIVER"); driver {
+	case "", "sqlite":
+		return newSQLiteRepository(DB), nil
+	case "couchdb":
+		return newCouchDBRepositoryFromEnv()
+	default:
+		return nil, fmt.Errorf("unknown DB_DRIVER %q", driver)
+	}
+}
+
+// GetRepository returns the process-wide repository configured by
+// InitDatabase.
+func GetRepository() Repository {
+	return repo
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"rwa-backend/models"
+
+	"gorm.io/gorm"
+)
+
+// sqliteRepository is the original GORM/SQLite-backed Repository.
+type sqliteRepository struct {
+	db *gorm.DB
+}
+
+func newSQLiteRepository(db *gorm.DB) Repository {
+	return &sqliteRepository{db: db}
+}
+
+func (r *sqliteRepository) CreateAsset(ctx context.Context, asset *models.Asset) error {
+	return r.db.WithContext(ctx).Create(asset).Error
+}
+
+func (r *sqliteRepository) GetAsset(ctx context.Context, id string) (*models.Asset, error) {
+	var asset models.Asset
+	if err := r.db.WithContext(ctx).First(&asset, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+func (r *sqliteRepository) ListAssets(ctx context.Context) ([]models.Asset, error) {
+	var assets []models.Asset
+	err := r.db.WithContext(ctx).Order("created_at DESC").Find(&assets).Error
+	return assets, err
+}
+
+func (r *sqliteRepository) UpdateAsset(ctx context.Context, asset *models.Asset) error {
+	return r.db.WithContext(ctx).Save(asset).Error
+}
+
+func (r *sqliteRepository) QueryAssets(ctx context.Context, filter AssetFilter) ([]models.Asset, error) {
+	query := r.db.WithContext(ctx).Order("created_at DESC")
+
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.MinYield > 0 {
+		query = query.Where("annual_yield >= ?", filter.MinYield)
+	}
+	if filter.Institution != "" {
+		query = query.Where("institution = ?", filter.Institution)
+	}
+
+	var assets []models.Asset
+	err := query.Find(&assets).Error
+	return assets, err
+}
@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"fmt"
+	"log"
 	"net/http"
+	"rwa-backend/chains"
 	"rwa-backend/database"
+	"rwa-backend/indexer"
+	"rwa-backend/mintpool"
 	"rwa-backend/models"
 	"rwa-backend/utils"
 	"strconv"
@@ -46,7 +50,37 @@ func MintAsset(c *gin.Context) {
 		pricePerRWA = parsedPrice
 	}
 
-	// Set contract address to "pending" if not provided
+	blockchain := req.Blockchain
+	if blockchain == "" {
+		blockchain = "Lisk"
+	}
+	networkVersion := req.NetworkVersion
+	if networkVersion == "" {
+		networkVersion = "mainnet"
+	}
+
+	draft := mintpool.MintDraft{
+		Name:               req.Name,
+		Symbol:             req.Symbol,
+		InstitutionName:    req.InstitutionName,
+		InstitutionAddress: req.InstitutionAddress,
+		Description:        req.Description,
+		TotalSupply:        totalSupply,
+		ExpectedYield:      expectedYield,
+		PricePerRWA:        pricePerRWA,
+		Blockchain:         blockchain,
+		NetworkVersion:     networkVersion,
+		DocumentsURI:       req.DocumentsURI,
+		ImageURI:           req.ImageURI,
+	}
+	assetID, err := mintpool.DeriveID(draft)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to derive asset ID", err.Error())
+		return
+	}
+
+	// Set contract address to "pending" unless the caller already deployed
+	// one client-side.
 	contractAddress := req.ContractAddress
 	if contractAddress == "" {
 		contractAddress = "pending"
@@ -54,7 +88,7 @@ func MintAsset(c *gin.Context) {
 
 	// Create asset model
 	asset := models.Asset{
-		ID:                 fmt.Sprintf("asset_%d", time.Now().Unix()),
+		ID:                 assetID,
 		Name:               req.Name,
 		Symbol:             req.Symbol,
 		Type:               "Real Estate", // Default for MVP
@@ -65,36 +99,68 @@ func MintAsset(c *gin.Context) {
 		StakedAmount:       0,           // Default
 		PriceUsd:           pricePerRWA, // Use the parsed or default price
 		AnnualYield:        expectedYield,
-		Blockchain:         "Lisk", // Default
+		Blockchain:         blockchain,
+		NetworkVersion:     networkVersion,
 		ContractAddress:    contractAddress,
 		TxHash:             req.TxHash,
 		DocumentsURI:       req.DocumentsURI,
 		ImageURI:           req.ImageURI,
 	}
 
-	// Save to database
-	db := database.GetDB()
-	if err := db.Create(&asset).Error; err != nil {
+	// assetID is a content-addressed hash of draft (see mintpool.DeriveID),
+	// so a client retrying an identical request after a timeout lands on
+	// this same ID. Resume from the existing row instead of racing
+	// CreateAsset into a duplicate-key error.
+	if existing, err := database.GetRepository().GetAsset(c.Request.Context(), assetID); err == nil {
+		asset = *existing
+	} else if err := database.GetRepository().CreateAsset(c.Request.Context(), &asset); err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to save asset", err.Error())
 		return
 	}
 
+	// If the caller didn't already deploy a contract client-side, enqueue a
+	// mint job; a worker in the mintpool signs, broadcasts and watches the
+	// deployment transaction, then flips ContractAddress once it's
+	// finalized. DeriveID makes this idempotent, so a client retrying an
+	// identical request after a timeout resumes the same job instead of
+	// minting a duplicate.
+	var job *models.MintJob
+	if req.ContractAddress == "" {
+		job, err = mintpool.GetPool().Submit(c.Request.Context(), draft)
+		if err != nil {
+			log.Printf("⚠️  mint: failed to enqueue mint job for %s: %v", asset.ID, err)
+		}
+	}
+
 	// Return success response with computed fields
 	assetResponse := asset.ToAssetResponse()
-	utils.SuccessResponse(c, http.StatusCreated, "Asset minted successfully", gin.H{
+	response := gin.H{
 		"id":    asset.ID,
 		"asset": assetResponse,
-	})
+	}
+	if job != nil {
+		response["mintJob"] = job
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Asset minted successfully", response)
 }
 
-// GetAllAssets handles GET /api/assets
+// GetAllAssets handles GET /api/assets?type=...&minYield=...&institution=...
 func GetAllAssets(c *gin.Context) {
-	var assets []models.Asset
-
-	db := database.GetDB()
+	filter := database.AssetFilter{
+		Type:        c.Query("type"),
+		Institution: c.Query("institution"),
+	}
+	if minYield := c.Query("minYield"); minYield != "" {
+		parsed, err := strconv.ParseFloat(minYield, 64)
+		if err != nil {
+			utils.ErrorResponse(c, http.StatusBadRequest, "Invalid minYield", err.Error())
+			return
+		}
+		filter.MinYield = parsed
+	}
 
-	// Fetch all assets ordered by creation date (newest first)
-	if err := db.Order("created_at DESC").Find(&assets).Error; err != nil {
+	assets, err := database.GetRepository().QueryAssets(c.Request.Context(), filter)
+	if err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to fetch assets", err.Error())
 		return
 	}
@@ -116,16 +182,18 @@ func GetAllAssets(c *gin.Context) {
 func GetAssetByID(c *gin.Context) {
 	id := c.Param("id")
 
-	var asset models.Asset
-	db := database.GetDB()
-
-	if err := db.First(&asset, "id = ?", id).Error; err != nil {
+	asset, err := database.GetRepository().GetAsset(c.Request.Context(), id)
+	if err != nil {
 		utils.ErrorResponse(c, http.StatusNotFound, "Asset not found", err.Error())
 		return
 	}
 
 	// Return with computed fields
 	assetResponse := asset.ToAssetResponse()
+	if topStakers, err := backendTopStakers(c, *asset); err == nil {
+		assetResponse.TopStakers = topStakers
+	}
+	attachHaltInfo(asset, &assetResponse)
 	utils.SuccessResponse(c, http.StatusOK, "Asset fetched successfully", assetResponse)
 }
 
@@ -141,9 +209,8 @@ func UpdateContractAddress(c *gin.Context) {
 	}
 
 	// Find asset
-	var asset models.Asset
-	db := database.GetDB()
-	if err := db.First(&asset, "id = ?", id).Error; err != nil {
+	asset, err := database.GetRepository().GetAsset(c.Request.Context(), id)
+	if err != nil {
 		utils.ErrorResponse(c, http.StatusNotFound, "Asset not found", err.Error())
 		return
 	}
@@ -153,14 +220,43 @@ func UpdateContractAddress(c *gin.Context) {
 	if req.TxHash != "" {
 		asset.TxHash = req.TxHash
 	}
+	blockchain := req.Blockchain
+	if blockchain == "" {
+		blockchain = asset.Blockchain
+	}
+	networkVersion := req.NetworkVersion
+	if networkVersion == "" {
+		networkVersion = asset.NetworkVersion
+	}
+	abiVersion := req.ABIVersion
+	if abiVersion == "" {
+		abiVersion = "v1"
+	}
+	asset.Blockchain = blockchain
+	asset.NetworkVersion = networkVersion
 	asset.UpdatedAt = time.Now()
 
 	// Save changes
-	if err := db.Save(&asset).Error; err != nil {
+	if err := database.GetRepository().UpdateAsset(c.Request.Context(), asset); err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to update asset", err.Error())
 		return
 	}
 
+	// Record this as a new deployment so GetAssetStats can pick the right
+	// decoder for this chain/ABI version going forward.
+	deployment := models.Deployment{
+		AssetID:         asset.ID,
+		Blockchain:      blockchain,
+		NetworkVersion:  networkVersion,
+		ContractAddress: req.ContractAddress,
+		DeployBlock:     req.DeployBlock,
+		ABIVersion:      abiVersion,
+		TxHash:          asset.TxHash,
+	}
+	if err := database.GetDB().Create(&deployment).Error; err != nil {
+		log.Printf("⚠️  update-contract: failed to record deployment for %s: %v", asset.ID, err)
+	}
+
 	// Return updated asset with computed fields
 	assetResponse := asset.ToAssetResponse()
 	utils.SuccessResponse(c, http.StatusOK, "Contract address updated successfully", assetResponse)
@@ -170,26 +266,119 @@ func UpdateContractAddress(c *gin.Context) {
 func GetAssetStats(c *gin.Context) {
 	id := c.Param("id")
 
-	var asset models.Asset
-	db := database.GetDB()
-
-	if err := db.First(&asset, "id = ?", id).Error; err != nil {
+	asset, err := database.GetRepository().GetAsset(c.Request.Context(), id)
+	if err != nil {
 		utils.ErrorResponse(c, http.StatusNotFound, "Asset not found", err.Error())
 		return
 	}
 
-	// For MVP, return calculated stats (in production, fetch from blockchain)
 	stats := models.TokenStats{
 		TotalSupply:       asset.TotalSupply,
 		CirculatingSupply: asset.TotalSupply, // All tokens are circulating for RWA
-		HolderCount:       3,                 // Mock holder count
+		HolderCount:       0,
 		Price:             asset.PriceUsd,
 		MarketCap:         float64(asset.TotalSupply) * asset.PriceUsd,
 	}
 
+	// Fill in real holder/supply data through the asset's chain backend; a
+	// contract still "pending" has nothing on-chain to query yet.
+	if asset.ContractAddress != "" && asset.ContractAddress != "pending" {
+		if backend, err := chains.Get(asset.Blockchain, asset.NetworkVersion); err == nil {
+			if holders, err := backend.Holders(c.Request.Context(), asset.ContractAddress, latestDeploymentBlock(*asset)); err == nil {
+				stats.HolderCount = int64(len(holders))
+			}
+
+			// Cross-check against the on-chain total supply using the ABI
+			// the contract was actually deployed with, so a later ABI
+			// version change doesn't break decoding for assets deployed
+			// under an earlier one. Best-effort: if the chain call fails
+			// (e.g. no RPC configured), the DB-cached TotalSupply above
+			// stands.
+			abiVersion := latestDeploymentABIVersion(*asset)
+			if onChainSupply, err := backend.TotalSupply(c.Request.Context(), asset.ContractAddress, abiVersion); err == nil {
+				stats.TotalSupply = onChainSupply.Int64()
+				stats.CirculatingSupply = stats.TotalSupply
+				stats.MarketCap = float64(stats.TotalSupply) * asset.PriceUsd
+			}
+		}
+	}
+
 	utils.SuccessResponse(c, http.StatusOK, "Asset stats fetched successfully", stats)
 }
 
+// latestDeploymentABIVersion returns the ABIVersion recorded on asset's most
+// recent Deployment, so callers decode on-chain data with the ABI the
+// contract was actually deployed with. Assets minted before ABIVersion
+// existed (or with no Deployment rows at all) fall back to "v1", matching
+// Deployment's own column default.
+func latestDeploymentABIVersion(asset models.Asset) string {
+	if len(asset.Deployments) == 0 {
+		return "v1"
+	}
+	if v := asset.Deployments[len(asset.Deployments)-1].ABIVersion; v != "" {
+		return v
+	}
+	return "v1"
+}
+
+// latestDeploymentBlock returns the DeployBlock recorded on asset's most
+// recent Deployment, so callers can scan an indexer from the contract's
+// actual deployment instead of genesis. Assets with no Deployment rows yet
+// fall back to 0, matching Deployment's own column default.
+func latestDeploymentBlock(asset models.Asset) uint64 {
+	if len(asset.Deployments) == 0 {
+		return 0
+	}
+	return asset.Deployments[len(asset.Deployments)-1].DeployBlock
+}
+
+// backendTopStakers resolves the chain backend for asset and returns its
+// top stakers, for use by GetAssetByID. A contract still "pending" has
+// nothing on-chain to query yet.
+func backendTopStakers(c *gin.Context, asset models.Asset) ([]models.Staker, error) {
+	if asset.ContractAddress == "" || asset.ContractAddress == "pending" {
+		return nil, fmt.Errorf("asset %s has no deployed contract", asset.ID)
+	}
+	ix, err := indexer.GetManager().For(asset.ContractAddress, latestDeploymentBlock(asset))
+	if err != nil {
+		return nil, err
+	}
+	_, topStakers, err := ix.Stats(defaultTopStakers)
+	return topStakers, err
+}
+
+const defaultTopStakers = 3
+
+// ReindexAsset handles POST /api/assets/:id/reindex, forcing a full
+// rescan of the asset's contract from its deployment block.
+func ReindexAsset(c *gin.Context) {
+	id := c.Param("id")
+
+	asset, err := database.GetRepository().GetAsset(c.Request.Context(), id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, "Asset not found", err.Error())
+		return
+	}
+
+	if asset.ContractAddress == "" || asset.ContractAddress == "pending" {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Asset has no deployed contract to reindex", "")
+		return
+	}
+
+	ix, err := indexer.GetManager().For(asset.ContractAddress, latestDeploymentBlock(*asset))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Indexer unavailable", err.Error())
+		return
+	}
+
+	if err := ix.Reindex(c.Request.Context()); err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Reindex failed", err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Reindex triggered successfully", gin.H{"id": asset.ID})
+}
+
 // UpdateAssetStaking handles PATCH /api/assets/:id/staking (for testing staking progress)
 func UpdateAssetStaking(c *gin.Context) {
 	id := c.Param("id")
@@ -205,9 +394,8 @@ func UpdateAssetStaking(c *gin.Context) {
 	}
 
 	// Find asset
-	var asset models.Asset
-	db := database.GetDB()
-	if err := db.First(&asset, "id = ?", id).Error; err != nil {
+	asset, err := database.GetRepository().GetAsset(c.Request.Context(), id)
+	if err != nil {
 		utils.ErrorResponse(c, http.StatusNotFound, "Asset not found", err.Error())
 		return
 	}
@@ -218,12 +406,22 @@ func UpdateAssetStaking(c *gin.Context) {
 		return
 	}
 
+	// Reject the write if the issuer has halted this asset and the chain
+	// has reached the halt's effective block.
+	if halt, err := activeHalt(asset); err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to check halt status", err.Error())
+		return
+	} else if halt != nil {
+		utils.ErrorResponse(c, http.StatusForbidden, "Asset is halted", halt.Reason)
+		return
+	}
+
 	// Update staked amount
 	asset.StakedAmount = req.StakedAmount
 	asset.UpdatedAt = time.Now()
 
 	// Save changes
-	if err := db.Save(&asset).Error; err != nil {
+	if err := database.GetRepository().UpdateAsset(c.Request.Context(), asset); err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to update staking", err.Error())
 		return
 	}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"rwa-backend/database"
+	"rwa-backend/models"
+	"rwa-backend/utils"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// HaltAsset handles POST /api/assets/:id/halt. The issuer signs a message
+// over the halt terms with the wallet at Asset.InstitutionAddress; once
+// the chain reaches EffectiveBlock, writes to the asset are rejected until
+// someone lifts the halt with a second signature.
+func HaltAsset(c *gin.Context) {
+	id := c.Param("id")
+	var req models.HaltRequest
+
+	if err := c.BindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	asset, err := database.GetRepository().GetAsset(c.Request.Context(), id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, "Asset not found", err.Error())
+		return
+	}
+	if asset.InstitutionAddress == "" {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Asset has no institution address configured", "")
+		return
+	}
+
+	message := haltMessage(asset.ID, req.Reason, req.EffectiveBlock)
+	if err := verifyPersonalSign(asset.InstitutionAddress, message, req.Signature); err != nil {
+		utils.ErrorResponse(c, http.StatusUnauthorized, "Signature verification failed", err.Error())
+		return
+	}
+
+	halt := models.AssetHalt{
+		AssetID:        asset.ID,
+		EffectiveBlock: req.EffectiveBlock,
+		Reason:         req.Reason,
+		SignerAddress:  asset.InstitutionAddress,
+		Signature:      req.Signature,
+	}
+	if err := database.GetDB().Create(&halt).Error; err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to record halt", err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Asset halt scheduled successfully", halt)
+}
+
+// LiftAssetHalt handles POST /api/assets/:id/halt/lift, requiring a second
+// signature from the same institution address before the most recent
+// active halt is cleared.
+func LiftAssetHalt(c *gin.Context) {
+	id := c.Param("id")
+	var req models.HaltLiftRequest
+
+	if err := c.BindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	asset, err := database.GetRepository().GetAsset(c.Request.Context(), id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, "Asset not found", err.Error())
+		return
+	}
+
+	var halt models.AssetHalt
+	err = database.GetDB().Where("asset_id = ? AND lifted_at IS NULL", asset.ID).Order("created_at DESC").First(&halt).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		utils.ErrorResponse(c, http.StatusNotFound, "No active halt to lift", "")
+		return
+	} else if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to load halt", err.Error())
+		return
+	}
+
+	message := liftMessage(asset.ID, halt.ID)
+	if err := verifyPersonalSign(asset.InstitutionAddress, message, req.Signature); err != nil {
+		utils.ErrorResponse(c, http.StatusUnauthorized, "Signature verification failed", err.Error())
+		return
+	}
+
+	now := time.Now()
+	halt.LiftedAt = &now
+	halt.LiftSignature = req.Signature
+	if err := database.GetDB().Save(&halt).Error; err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to lift halt", err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Asset halt lifted successfully", halt)
+}
+
+// attachHaltInfo fills in the governance halt state on an AssetResponse.
+func attachHaltInfo(asset *models.Asset, resp *models.AssetResponse) {
+	var halt models.AssetHalt
+	err := database.GetDB().Where("asset_id = ? AND lifted_at IS NULL", asset.ID).Order("created_at DESC").First(&halt).Error
+	if err != nil {
+		return
+	}
+	resp.HaltInfo = &halt
+	resp.IsHalted = halt.IsActive(currentIndexedBlock(asset.ContractAddress))
+}
+
+// activeHalt returns the asset's active halt, if any, so write handlers
+// can reject the request before touching state.
+func activeHalt(asset *models.Asset) (*models.AssetHalt, error) {
+	var halt models.AssetHalt
+	err := database.GetDB().Where("asset_id = ? AND lifted_at IS NULL", asset.ID).Order("created_at DESC").First(&halt).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if !halt.IsActive(currentIndexedBlock(asset.ContractAddress)) {
+		return nil, nil
+	}
+	return &halt, nil
+}
+
+// currentIndexedBlock looks up the indexer's progress for contractAddress,
+// returning 0 for an asset that hasn't been indexed yet (e.g. still
+// "pending"), which simply means no halt can have taken effect.
+func currentIndexedBlock(contractAddress string) uint64 {
+	if contractAddress == "" || contractAddress == "pending" {
+		return 0
+	}
+	var cursor models.IndexerCursor
+	if err := database.GetDB().Where("contract_address = ?", contractAddress).First(&cursor).Error; err != nil {
+		return 0
+	}
+	return cursor.LastIndexedBlock
+}
+
+func haltMessage(assetID, reason string, effectiveBlock uint64) string {
+	return fmt.Sprintf("EVERA-HALT:%s:%d:%s", assetID, effectiveBlock, reason)
+}
+
+func liftMessage(assetID string, haltID uint) string {
+	return fmt.Sprintf("EVERA-LIFT:%s:%d", assetID, haltID)
+}
+
+// verifyPersonalSign checks that signatureHex is an EIP-191 personal_sign
+// signature of message by expectedAddress, recovering the signer with
+// go-ethereum's crypto.SigToPub.
+func verifyPersonalSign(expectedAddress, message, signatureHex string) error {
+	sig, err := hexutil.Decode(signatureHex)
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if len(sig) != 65 {
+		return fmt.Errorf("invalid signature length: got %d bytes, want 65", len(sig))
+	}
+
+	// crypto.SigToPub expects a 0/1 recovery id; personal_sign wallets
+	// typically produce 27/28.
+	sig = append([]byte(nil), sig...)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	hash := accounts.TextHash([]byte(message))
+	pubKey, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return fmt.Errorf("recover signer: %w", err)
+	}
+
+	recovered := crypto.PubkeyToAddress(*pubKey)
+	if !strings.EqualFold(recovered.Hex(), expectedAddress) {
+		return fmt.Errorf("recovered address %s does not match institution address", recovered.Hex())
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// personalSign signs message the way an EIP-191 personal_sign wallet
+// would, including the 27/28 recovery id verifyPersonalSign has to
+// normalize back down to 0/1.
+func personalSign(t *testing.T, key string, message string) string {
+	t.Helper()
+	privKey, err := crypto.HexToECDSA(key)
+	if err != nil {
+		t.Fatalf("parse test key: %v", err)
+	}
+
+	hash := accounts.TextHash([]byte(message))
+	sig, err := crypto.Sign(hash, privKey)
+	if err != nil {
+		t.Fatalf("sign test message: %v", err)
+	}
+	sig[64] += 27
+	return hexutil.Encode(sig)
+}
+
+func TestVerifyPersonalSignAcceptsMatchingSigner(t *testing.T) {
+	const privKey = "a59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690"
+	address := crypto.PubkeyToAddress(mustPrivateKey(t, privKey).PublicKey).Hex()
+
+	signature := personalSign(t, privKey, "EVERA-HALT:asset_1:100:reason")
+
+	if err := verifyPersonalSign(address, "EVERA-HALT:asset_1:100:reason", signature); err != nil {
+		t.Errorf("verifyPersonalSign() = %v, want nil for a signature from the expected address", err)
+	}
+}
+
+func TestVerifyPersonalSignRejectsWrongSigner(t *testing.T) {
+	const signerKey = "a59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690"
+	const otherAddress = "0x0000000000000000000000000000000000dEaD"
+
+	signature := personalSign(t, signerKey, "EVERA-HALT:asset_1:100:reason")
+
+	if err := verifyPersonalSign(otherAddress, "EVERA-HALT:asset_1:100:reason", signature); err == nil {
+		t.Error("verifyPersonalSign() = nil, want an error for a signature from a different address")
+	}
+}
+
+func TestVerifyPersonalSignRejectsTamperedMessage(t *testing.T) {
+	const privKey = "a59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690"
+	address := crypto.PubkeyToAddress(mustPrivateKey(t, privKey).PublicKey).Hex()
+
+	signature := personalSign(t, privKey, "EVERA-HALT:asset_1:100:reason")
+
+	if err := verifyPersonalSign(address, "EVERA-HALT:asset_1:999:reason", signature); err == nil {
+		t.Error("verifyPersonalSign() = nil, want an error when the signed message doesn't match")
+	}
+}
+
+func TestVerifyPersonalSignRejectsMalformedSignature(t *testing.T) {
+	if err := verifyPersonalSign("0xabc", "message", "not-hex"); err == nil {
+		t.Error("verifyPersonalSign() = nil, want an error for an undecodable signature")
+	}
+	if err := verifyPersonalSign("0xabc", "message", "0x1234"); err == nil {
+		t.Error("verifyPersonalSign() = nil, want an error for a signature shorter than 65 bytes")
+	}
+}
+
+func mustPrivateKey(t *testing.T, hexKey string) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		t.Fatalf("parse test key: %v", err)
+	}
+	return key
+}
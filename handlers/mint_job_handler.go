@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"rwa-backend/mintpool"
+	"rwa-backend/utils"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// GetMintJob handles GET /api/mint-jobs/:id, for polling a job enqueued by
+// MintAsset through pending/submitted/mined/confirmed/finalized (or
+// failed).
+func GetMintJob(c *gin.Context) {
+	id := c.Param("id")
+
+	job, err := mintpool.GetPool().Get(c.Request.Context(), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		utils.ErrorResponse(c, http.StatusNotFound, "Mint job not found", "")
+		return
+	} else if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to fetch mint job", err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Mint job fetched successfully", job)
+}
+
+// RetryMintJob handles POST /api/mint-jobs/:id/retry, letting an operator
+// resume a job that ended in the failed state (e.g. the deployer ran out
+// of gas, or the RPC endpoint was briefly down).
+func RetryMintJob(c *gin.Context) {
+	id := c.Param("id")
+
+	job, err := mintpool.GetPool().Retry(c.Request.Context(), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		utils.ErrorResponse(c, http.StatusNotFound, "Mint job not found", "")
+		return
+	} else if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Failed to retry mint job", err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Mint job retried successfully", job)
+}
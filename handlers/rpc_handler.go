@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"rwa-backend/api/routes"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// rpcRequest/rpcResponse follow JSON-RPC 2.0 (https://www.jsonrpc.org/specification).
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+const (
+	rpcParseError     = -32700
+	rpcMethodNotFound = -32601
+	rpcInternalError  = -32000
+)
+
+// NewRPCHandler returns the handler for POST /rpc/v1. Every REST route in
+// api/routes is reachable here under its RPCMethod name; rather than
+// duplicating each handler's logic, a call is translated into an
+// in-process HTTP request against engine and the REST response is
+// wrapped back into a JSON-RPC one, so the two surfaces can never drift
+// in behavior (only in shape).
+func NewRPCHandler(engine *gin.Engine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req rpcRequest
+		if err := c.BindJSON(&req); err != nil {
+			writeRPCError(c, nil, rpcParseError, "invalid JSON-RPC request: "+err.Error())
+			return
+		}
+		if req.JSONRPC != "2.0" {
+			writeRPCError(c, req.ID, rpcInternalError, "jsonrpc must be \"2.0\"")
+			return
+		}
+
+		route, ok := routes.ByRPCMethod(req.Method)
+		if !ok {
+			writeRPCError(c, req.ID, rpcMethodNotFound, "unknown method: "+req.Method)
+			return
+		}
+
+		path, body, err := buildRESTRequest(route, req.Params)
+		if err != nil {
+			writeRPCError(c, req.ID, rpcInternalError, err.Error())
+			return
+		}
+
+		httpReq := httptest.NewRequest(route.Method, path, bytes.NewReader(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, httpReq)
+
+		// >=300 also catches gin's RedirectTrailingSlash/RedirectFixedPath
+		// 3xx responses, whose bodies are HTML, not JSON, and would
+		// otherwise get wrapped as a "successful" RawMessage result.
+		if rec.Code >= 300 {
+			writeRPCError(c, req.ID, rpcInternalError, strings.TrimSpace(rec.Body.String()))
+			return
+		}
+
+		c.JSON(200, rpcResponse{JSONRPC: "2.0", Result: json.RawMessage(rec.Body.Bytes()), ID: req.ID})
+	}
+}
+
+// buildRESTRequest turns JSON-RPC params into the REST request route
+// expects: any field matching a ":name" path segment is substituted into
+// the URL, and everything else becomes the JSON body.
+func buildRESTRequest(route routes.Route, params json.RawMessage) (path string, body []byte, err error) {
+	fields := map[string]json.RawMessage{}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &fields); err != nil {
+			return "", nil, err
+		}
+	}
+
+	path = route.Path
+	for _, segment := range strings.Split(route.Path, "/") {
+		if !strings.HasPrefix(segment, ":") {
+			continue
+		}
+		name := segment[1:]
+		raw, ok := fields[name]
+		if !ok {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			value = strings.Trim(string(raw), `"`)
+		}
+		path = strings.Replace(path, segment, value, 1)
+		delete(fields, name)
+	}
+
+	if len(fields) == 0 {
+		return path, nil, nil
+	}
+
+	// GET handlers in this service read filters from the query string
+	// (see GetAllAssets), not a request body, so mirror that instead of
+	// silently dropping the remaining params.
+	if route.Method == "GET" {
+		query := url.Values{}
+		for name, raw := range fields {
+			var value string
+			if err := json.Unmarshal(raw, &value); err != nil {
+				value = strings.Trim(string(raw), `"`)
+			}
+			query.Set(name, value)
+		}
+		return path + "?" + query.Encode(), nil, nil
+	}
+
+	body, err = json.Marshal(fields)
+	return path, body, err
+}
+
+func writeRPCError(c *gin.Context, id json.RawMessage, code int, message string) {
+	c.JSON(200, rpcResponse{JSONRPC: "2.0", Error: &rpcError{Code: code, Message: message}, ID: id})
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/url"
+	"rwa-backend/api/routes"
+	"strings"
+	"testing"
+)
+
+func TestBuildRESTRequestSubstitutesPathParams(t *testing.T) {
+	route := routes.Route{Method: "GET", Path: "/api/assets/:id/stats"}
+	params := json.RawMessage(`{"id":"asset_123"}`)
+
+	path, body, err := buildRESTRequest(route, params)
+	if err != nil {
+		t.Fatalf("buildRESTRequest: %v", err)
+	}
+	if path != "/api/assets/asset_123/stats" {
+		t.Errorf("path = %q, want /api/assets/asset_123/stats", path)
+	}
+	if body != nil {
+		t.Errorf("body = %q, want nil once the only param is consumed by the path", body)
+	}
+}
+
+func TestBuildRESTRequestEscapesGetQueryParams(t *testing.T) {
+	route := routes.Route{Method: "GET", Path: "/api/assets"}
+	params := json.RawMessage(`{"institution":"Acme & Co #1"}`)
+
+	path, body, err := buildRESTRequest(route, params)
+	if err != nil {
+		t.Fatalf("buildRESTRequest: %v", err)
+	}
+	if body != nil {
+		t.Errorf("body = %q, want nil for a GET request", body)
+	}
+
+	rawQuery := strings.TrimPrefix(path, "/api/assets?")
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		t.Fatalf("query string %q failed to parse: %v", path, err)
+	}
+	if got := values.Get("institution"); got != "Acme & Co #1" {
+		t.Errorf("institution query param round-tripped as %q, want %q", got, "Acme & Co #1")
+	}
+}
+
+func TestBuildRESTRequestEncodesNonGetBodyFields(t *testing.T) {
+	route := routes.Route{Method: "POST", Path: "/api/assets/:id/halt"}
+	params := json.RawMessage(`{"id":"asset_123","reason":"fraud","effectiveBlock":100,"signature":"0xabc"}`)
+
+	path, body, err := buildRESTRequest(route, params)
+	if err != nil {
+		t.Fatalf("buildRESTRequest: %v", err)
+	}
+	if path != "/api/assets/asset_123/halt" {
+		t.Errorf("path = %q, want /api/assets/asset_123/halt", path)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("body %q is not valid JSON: %v", body, err)
+	}
+	if _, ok := decoded["id"]; ok {
+		t.Errorf("body %q still contains id, want it consumed by the path substitution", body)
+	}
+	if decoded["reason"] != "fraud" {
+		t.Errorf("body missing reason field: %q", body)
+	}
+}
+
+func TestBuildRESTRequestWithNoParams(t *testing.T) {
+	route := routes.Route{Method: "GET", Path: "/api/health"}
+
+	path, body, err := buildRESTRequest(route, nil)
+	if err != nil {
+		t.Fatalf("buildRESTRequest: %v", err)
+	}
+	if path != "/api/health" {
+		t.Errorf("path = %q, want /api/health unchanged", path)
+	}
+	if body != nil {
+		t.Errorf("body = %q, want nil", body)
+	}
+}
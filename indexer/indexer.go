@@ -0,0 +1,437 @@
+// Package indexer keeps the Holder, StakeEvent and Transfer tables in sync
+// with a deployed asset's staking/ERC-20 contract on Lisk, so the API layer
+// never has to return mock on-chain data.
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"rwa-backend/models"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"gorm.io/gorm"
+)
+
+// reorgWindow is the number of trailing blocks treated as unfinalized; any
+// block older than head-reorgWindow is assumed final and is never rolled
+// back.
+const reorgWindow = 12
+
+// transferEventSig and stakeEventSig are the Keccak256 topic0 hashes for
+// `Transfer(address,address,uint256)` and `Staked(address,uint256)`.
+var (
+	transferEventSig = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	stakeEventSig    = common.HexToHash("0x9e71bc8eea02a63969f509818f2dafb9254532904319f9dbda79b67bd34a5f3d")
+)
+
+var erc20TransferEvent abi.Event
+
+// Indexer tracks a single contract's events on a single chain. One Indexer
+// is created per deployed asset contract.
+type Indexer struct {
+	db              *gorm.DB
+	client          *ethclient.Client
+	wsClient        *ethclient.Client
+	contractAddress string
+	deployBlock     uint64
+}
+
+// New dials the given JSON-RPC endpoint (and, if provided, a WebSocket
+// endpoint for `eth_subscribe`) and returns an Indexer for contractAddress.
+// When wsURL is empty, or the WebSocket dial fails, the indexer falls back
+// to polling rpcURL for new heads.
+func New(rpcURL, wsURL, contractAddress string, deployBlock uint64, db *gorm.DB) (*Indexer, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial rpc: %w", err)
+	}
+
+	ix := &Indexer{
+		db:              db,
+		client:          client,
+		contractAddress: contractAddress,
+		deployBlock:     deployBlock,
+	}
+
+	if wsURL != "" {
+		if wsClient, err := ethclient.Dial(wsURL); err == nil {
+			ix.wsClient = wsClient
+		} else {
+			log.Printf("⚠️  indexer: websocket dial failed for %s, falling back to polling: %v", contractAddress, err)
+		}
+	}
+
+	return ix, nil
+}
+
+// Start begins following new heads until ctx is cancelled, processing each
+// one as it arrives. It blocks, so callers should run it in a goroutine.
+func (ix *Indexer) Start(ctx context.Context) error {
+	if ix.wsClient != nil {
+		return ix.followSubscription(ctx)
+	}
+	return ix.followPolling(ctx)
+}
+
+func (ix *Indexer) followSubscription(ctx context.Context) error {
+	headers := make(chan *types.Header)
+	sub, err := ix.wsClient.SubscribeNewHead(ctx, headers)
+	if err != nil {
+		log.Printf("⚠️  indexer: subscribe failed for %s, falling back to polling: %v", ix.contractAddress, err)
+		return ix.followPolling(ctx)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			log.Printf("⚠️  indexer: subscription error for %s, falling back to polling: %v", ix.contractAddress, err)
+			return ix.followPolling(ctx)
+		case header := <-headers:
+			if err := ix.handleNewHead(ctx, header); err != nil {
+				log.Printf("⚠️  indexer: failed to process head %d for %s: %v", header.Number.Uint64(), ix.contractAddress, err)
+			}
+		}
+	}
+}
+
+func (ix *Indexer) followPolling(ctx context.Context) error {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			header, err := ix.client.HeaderByNumber(ctx, nil)
+			if err != nil {
+				log.Printf("⚠️  indexer: poll failed for %s: %v", ix.contractAddress, err)
+				continue
+			}
+			if err := ix.handleNewHead(ctx, header); err != nil {
+				log.Printf("⚠️  indexer: failed to process head %d for %s: %v", header.Number.Uint64(), ix.contractAddress, err)
+			}
+		}
+	}
+}
+
+// handleNewHead compares the new head's parent hash against the stored
+// cursor. If they disagree, a reorg has happened below the new head and
+// every event in the affected unfinalized window is rolled back and
+// re-scanned; otherwise the indexer simply scans forward to the new head.
+func (ix *Indexer) handleNewHead(ctx context.Context, header *types.Header) error {
+	cursor, err := ix.loadCursor()
+	if err != nil {
+		return err
+	}
+
+	head := header.Number.Uint64()
+
+	if cursor.LastBlockHash != "" && cursor.LastIndexedBlock > 0 {
+		expectedParent, err := ix.client.HeaderByNumber(ctx, new(big.Int).SetUint64(cursor.LastIndexedBlock))
+		if err == nil && expectedParent.Hash().Hex() != cursor.LastBlockHash {
+			rollbackFrom := cursor.LastFinalizedBlock
+			log.Printf("⚠️  indexer: reorg detected for %s, rolling back from block %d", ix.contractAddress, rollbackFrom)
+			if err := ix.rollback(rollbackFrom); err != nil {
+				return fmt.Errorf("rollback: %w", err)
+			}
+			cursor.LastIndexedBlock = rollbackFrom
+		}
+	}
+
+	from := cursor.LastIndexedBlock + 1
+	if cursor.LastIndexedBlock == 0 {
+		from = ix.deployBlock
+	}
+	if from > head {
+		return nil
+	}
+
+	if err := ix.scanRange(ctx, from, head); err != nil {
+		return err
+	}
+
+	finalized := uint64(0)
+	if head > reorgWindow {
+		finalized = head - reorgWindow
+	}
+
+	return ix.saveCursor(head, finalized, header.Hash().Hex())
+}
+
+// scanRange pulls Transfer and Staked logs for [from, to], persists them,
+// and folds the Transfer logs into Holder balances.
+func (ix *Indexer) scanRange(ctx context.Context, from, to uint64) error {
+	addr := common.HexToAddress(ix.contractAddress)
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{addr},
+		Topics:    [][]common.Hash{{transferEventSig, stakeEventSig}},
+	}
+
+	logs, err := ix.client.FilterLogs(ctx, query)
+	if err != nil {
+		return fmt.Errorf("eth_getLogs: %w", err)
+	}
+
+	for _, vLog := range logs {
+		switch vLog.Topics[0] {
+		case transferEventSig:
+			if err := ix.applyTransfer(vLog); err != nil {
+				return err
+			}
+		case stakeEventSig:
+			if err := ix.applyStake(vLog); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ix *Indexer) applyTransfer(vLog types.Log) error {
+	if len(vLog.Topics) < 3 {
+		return errors.New("malformed transfer log: missing indexed topics")
+	}
+	from := common.HexToAddress(vLog.Topics[1].Hex()).Hex()
+	to := common.HexToAddress(vLog.Topics[2].Hex()).Hex()
+	value := new(big.Int).SetBytes(vLog.Data)
+
+	transfer := models.Transfer{
+		ContractAddress: ix.contractAddress,
+		BlockNumber:     vLog.BlockNumber,
+		LogIndex:        uint(vLog.Index),
+		From:            from,
+		To:              to,
+		Value:           value.String(),
+		TxHash:          vLog.TxHash.Hex(),
+	}
+
+	return ix.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses().Create(&transfer).Error; err != nil {
+			return err
+		}
+		if from != common.HexToAddress("0x0").Hex() {
+			if err := ix.adjustBalance(tx, from, new(big.Int).Neg(value), vLog.BlockNumber); err != nil {
+				return err
+			}
+		}
+		return ix.adjustBalance(tx, to, value, vLog.BlockNumber)
+	})
+}
+
+func (ix *Indexer) applyStake(vLog types.Log) error {
+	if len(vLog.Topics) < 2 {
+		return errors.New("malformed stake log: missing indexed topic")
+	}
+	staker := common.HexToAddress(vLog.Topics[1].Hex()).Hex()
+	amount := new(big.Int).SetBytes(vLog.Data)
+
+	event := models.StakeEvent{
+		ContractAddress: ix.contractAddress,
+		BlockNumber:     vLog.BlockNumber,
+		LogIndex:        uint(vLog.Index),
+		StakerAddress:   staker,
+		Amount:          amount.String(),
+		EventType:       "stake",
+		TxHash:          vLog.TxHash.Hex(),
+	}
+
+	return ix.db.Create(&event).Error
+}
+
+func (ix *Indexer) adjustBalance(tx *gorm.DB, address string, delta *big.Int, block uint64) error {
+	var holder models.Holder
+	err := tx.Where("contract_address = ? AND address = ?", ix.contractAddress, address).First(&holder).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		holder = models.Holder{ContractAddress: ix.contractAddress, Address: address, Balance: "0"}
+	} else if err != nil {
+		return err
+	}
+
+	current, ok := new(big.Int).SetString(holder.Balance, 10)
+	if !ok {
+		current = big.NewInt(0)
+	}
+	holder.Balance = new(big.Int).Add(current, delta).String()
+	holder.UpdatedAtBlock = block
+
+	return tx.Save(&holder).Error
+}
+
+// rollback deletes every Transfer, StakeEvent and Holder delta at or after
+// fromBlock so the next scan can rebuild them from the canonical chain.
+func (ix *Indexer) rollback(fromBlock uint64) error {
+	return ix.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("contract_address = ? AND block_number >= ?", ix.contractAddress, fromBlock).
+			Delete(&models.Transfer{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("contract_address = ? AND block_number >= ?", ix.contractAddress, fromBlock).
+			Delete(&models.StakeEvent{}).Error; err != nil {
+			return err
+		}
+		// Holder balances are derived state; recompute them from the
+		// remaining Transfer rows rather than trying to subtract deltas.
+		return ix.recomputeHolders(tx)
+	})
+}
+
+func (ix *Indexer) recomputeHolders(tx *gorm.DB) error {
+	if err := tx.Where("contract_address = ?", ix.contractAddress).Delete(&models.Holder{}).Error; err != nil {
+		return err
+	}
+
+	var transfers []models.Transfer
+	if err := tx.Where("contract_address = ?", ix.contractAddress).Order("block_number, log_index").Find(&transfers).Error; err != nil {
+		return err
+	}
+
+	balances := map[string]*big.Int{}
+	lastBlock := map[string]uint64{}
+	zero := common.HexToAddress("0x0").Hex()
+	for _, t := range transfers {
+		value, ok := new(big.Int).SetString(t.Value, 10)
+		if !ok {
+			continue
+		}
+		if t.From != zero {
+			if balances[t.From] == nil {
+				balances[t.From] = big.NewInt(0)
+			}
+			balances[t.From].Sub(balances[t.From], value)
+			lastBlock[t.From] = t.BlockNumber
+		}
+		if balances[t.To] == nil {
+			balances[t.To] = big.NewInt(0)
+		}
+		balances[t.To].Add(balances[t.To], value)
+		lastBlock[t.To] = t.BlockNumber
+	}
+
+	for address, balance := range balances {
+		holder := models.Holder{
+			ContractAddress: ix.contractAddress,
+			Address:         address,
+			Balance:         balance.String(),
+			UpdatedAtBlock:  lastBlock[address],
+		}
+		if err := tx.Create(&holder).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reindex drops all indexed state for this contract and rescans from the
+// deployment block. It backs POST /api/assets/:id/reindex.
+func (ix *Indexer) Reindex(ctx context.Context) error {
+	if err := ix.rollback(ix.deployBlock); err != nil {
+		return err
+	}
+	if err := ix.db.Where("contract_address = ?", ix.contractAddress).Delete(&models.IndexerCursor{}).Error; err != nil {
+		return err
+	}
+
+	header, err := ix.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("fetch head: %w", err)
+	}
+	return ix.handleNewHead(ctx, header)
+}
+
+// Stats computes holder count and the top-N stakers from indexed tables,
+// for use by GetAssetStats instead of mock data.
+func (ix *Indexer) Stats(topN int) (holderCount int64, topStakers []models.Staker, err error) {
+	if err = ix.db.Model(&models.Holder{}).
+		Where("contract_address = ? AND balance != '0'", ix.contractAddress).
+		Count(&holderCount).Error; err != nil {
+		return 0, nil, err
+	}
+
+	totals := map[string]*big.Int{}
+	var events []models.StakeEvent
+	if err = ix.db.Where("contract_address = ?", ix.contractAddress).Find(&events).Error; err != nil {
+		return 0, nil, err
+	}
+	for _, e := range events {
+		amount, ok := new(big.Int).SetString(e.Amount, 10)
+		if !ok {
+			continue
+		}
+		if totals[e.StakerAddress] == nil {
+			totals[e.StakerAddress] = big.NewInt(0)
+		}
+		if e.EventType == "unstake" {
+			totals[e.StakerAddress].Sub(totals[e.StakerAddress], amount)
+		} else {
+			totals[e.StakerAddress].Add(totals[e.StakerAddress], amount)
+		}
+	}
+
+	totalStaked := big.NewInt(0)
+	for _, amount := range totals {
+		totalStaked.Add(totalStaked, amount)
+	}
+
+	stakers := make([]models.Staker, 0, len(totals))
+	for address, amount := range totals {
+		amountF, _ := new(big.Float).SetInt(amount).Float64()
+		var pct float64
+		if totalStaked.Sign() > 0 {
+			totalF, _ := new(big.Float).SetInt(totalStaked).Float64()
+			pct = amountF / totalF * 100
+		}
+		stakers = append(stakers, models.Staker{Address: address, Amount: amountF, Percentage: pct})
+	}
+
+	sort.Slice(stakers, func(i, j int) bool { return stakers[i].Amount > stakers[j].Amount })
+	if len(stakers) > topN {
+		stakers = stakers[:topN]
+	}
+
+	return holderCount, stakers, nil
+}
+
+// Holders returns every address with a nonzero balance for this contract,
+// read directly from the indexed Holder table. This is the full holder
+// set, distinct from Stats's stake-weighted leaderboard.
+func (ix *Indexer) Holders() ([]models.Holder, error) {
+	var holders []models.Holder
+	err := ix.db.Where("contract_address = ? AND balance != '0'", ix.contractAddress).Find(&holders).Error
+	return holders, err
+}
+
+func (ix *Indexer) loadCursor() (models.IndexerCursor, error) {
+	var cursor models.IndexerCursor
+	err := ix.db.Where("contract_address = ?", ix.contractAddress).First(&cursor).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.IndexerCursor{ContractAddress: ix.contractAddress}, nil
+	}
+	return cursor, err
+}
+
+func (ix *Indexer) saveCursor(lastIndexed, lastFinalized uint64, blockHash string) error {
+	cursor := models.IndexerCursor{
+		ContractAddress:    ix.contractAddress,
+		LastIndexedBlock:   lastIndexed,
+		LastFinalizedBlock: lastFinalized,
+		LastBlockHash:      blockHash,
+	}
+	return ix.db.Save(&cursor).Error
+}
@@ -0,0 +1,115 @@
+package indexer
+
+import (
+	"fmt"
+	"math/big"
+	"rwa-backend/models"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	_ "modernc.org/sqlite"
+)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	// Named so each test gets its own database: an unnamed
+	// "file::memory:?cache=shared" DSN is shared by every gorm.Open call in
+	// the process, so two tests seeding the same (contract, block, logIndex)
+	// key would collide on the unique index.
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+	db, err := gorm.Open(sqlite.Dialector{DriverName: "sqlite", DSN: dsn}, &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open test db: %v", err)
+	}
+	if err := db.AutoMigrate(&models.Transfer{}, &models.StakeEvent{}, &models.Holder{}); err != nil {
+		t.Fatalf("migrate test db: %v", err)
+	}
+	return db
+}
+
+func TestRecomputeHoldersFoldsTransfersIntoBalances(t *testing.T) {
+	db := newTestDB(t)
+	ix := &Indexer{db: db, contractAddress: "0xcontract"}
+
+	transfers := []models.Transfer{
+		{ContractAddress: "0xcontract", BlockNumber: 1, LogIndex: 0, From: "0x0000000000000000000000000000000000000000", To: "0xalice", Value: "100"},
+		{ContractAddress: "0xcontract", BlockNumber: 2, LogIndex: 0, From: "0xalice", To: "0xbob", Value: "40"},
+	}
+	if err := db.Create(&transfers).Error; err != nil {
+		t.Fatalf("seed transfers: %v", err)
+	}
+
+	if err := ix.recomputeHolders(db); err != nil {
+		t.Fatalf("recomputeHolders: %v", err)
+	}
+
+	balance := func(address string) string {
+		var holder models.Holder
+		if err := db.Where("contract_address = ? AND address = ?", "0xcontract", address).First(&holder).Error; err != nil {
+			t.Fatalf("load holder %s: %v", address, err)
+		}
+		return holder.Balance
+	}
+
+	if got, want := balance("0xalice"), big.NewInt(60).String(); got != want {
+		t.Errorf("alice balance = %s, want %s", got, want)
+	}
+	if got, want := balance("0xbob"), big.NewInt(40).String(); got != want {
+		t.Errorf("bob balance = %s, want %s", got, want)
+	}
+}
+
+func TestRollbackDropsEventsAtOrAfterFromBlockAndRecomputesHolders(t *testing.T) {
+	db := newTestDB(t)
+	ix := &Indexer{db: db, contractAddress: "0xcontract"}
+
+	transfers := []models.Transfer{
+		{ContractAddress: "0xcontract", BlockNumber: 1, LogIndex: 0, From: "0x0000000000000000000000000000000000000000", To: "0xalice", Value: "100"},
+		{ContractAddress: "0xcontract", BlockNumber: 5, LogIndex: 0, From: "0xalice", To: "0xbob", Value: "40"}, // will be reorged out
+	}
+	if err := db.Create(&transfers).Error; err != nil {
+		t.Fatalf("seed transfers: %v", err)
+	}
+	events := []models.StakeEvent{
+		{ContractAddress: "0xcontract", BlockNumber: 1, LogIndex: 0, StakerAddress: "0xalice", Amount: "10", EventType: "stake"},
+		{ContractAddress: "0xcontract", BlockNumber: 5, LogIndex: 0, StakerAddress: "0xbob", Amount: "5", EventType: "stake"},
+	}
+	if err := db.Create(&events).Error; err != nil {
+		t.Fatalf("seed stake events: %v", err)
+	}
+
+	if err := ix.rollback(5); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+
+	var remainingTransfers []models.Transfer
+	if err := db.Find(&remainingTransfers).Error; err != nil {
+		t.Fatalf("load transfers: %v", err)
+	}
+	if len(remainingTransfers) != 1 || remainingTransfers[0].BlockNumber != 1 {
+		t.Errorf("transfers after rollback = %+v, want only the block-1 transfer", remainingTransfers)
+	}
+
+	var remainingEvents []models.StakeEvent
+	if err := db.Find(&remainingEvents).Error; err != nil {
+		t.Fatalf("load stake events: %v", err)
+	}
+	if len(remainingEvents) != 1 || remainingEvents[0].BlockNumber != 1 {
+		t.Errorf("stake events after rollback = %+v, want only the block-1 event", remainingEvents)
+	}
+
+	var holder models.Holder
+	if err := db.Where("contract_address = ? AND address = ?", "0xcontract", "0xalice").First(&holder).Error; err != nil {
+		t.Fatalf("load alice holder: %v", err)
+	}
+	if holder.Balance != big.NewInt(100).String() {
+		t.Errorf("alice balance after rollback = %s, want 100 (block-5 transfer rolled back)", holder.Balance)
+	}
+
+	var bobCount int64
+	db.Model(&models.Holder{}).Where("contract_address = ? AND address = ?", "0xcontract", "0xbob").Count(&bobCount)
+	if bobCount != 0 {
+		t.Errorf("bob should have no holder row once its only transfer is rolled back, found %d", bobCount)
+	}
+}
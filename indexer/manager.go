@@ -0,0 +1,69 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"rwa-backend/database"
+	"sync"
+)
+
+// defaultTopStakers is how many stakers GetAssetStats surfaces, matching
+// the size of the old mock TopStakers list.
+const defaultTopStakers = 3
+
+var (
+	managerOnce sync.Once
+	manager     *Manager
+)
+
+// Manager hands out a singleton Indexer per contract address, dialing RPC
+// lazily on first use so assets without on-chain activity yet don't pay
+// for a connection.
+type Manager struct {
+	mu       sync.Mutex
+	indexers map[string]*Indexer
+	rpcURL   string
+	wsURL    string
+}
+
+// GetManager returns the process-wide indexer manager, configured from
+// LISK_RPC_URL / LISK_WS_URL (mirroring database's env-driven setup).
+func GetManager() *Manager {
+	managerOnce.Do(func() {
+		manager = &Manager{
+			indexers: make(map[string]*Indexer),
+			rpcURL:   os.Getenv("LISK_RPC_URL"),
+			wsURL:    os.Getenv("LISK_WS_URL"),
+		}
+	})
+	return manager
+}
+
+// For retrieves (or lazily creates) the Indexer for contractAddress,
+// deployed at deployBlock.
+func (m *Manager) For(contractAddress string, deployBlock uint64) (*Indexer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ix, ok := m.indexers[contractAddress]; ok {
+		return ix, nil
+	}
+
+	if m.rpcURL == "" {
+		return nil, fmt.Errorf("LISK_RPC_URL is not configured")
+	}
+
+	ix, err := New(m.rpcURL, m.wsURL, contractAddress, deployBlock, database.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	m.indexers[contractAddress] = ix
+	go func() {
+		if err := ix.Start(context.Background()); err != nil {
+			fmt.Printf("⚠️  indexer: stopped following %s: %v\n", contractAddress, err)
+		}
+	}()
+
+	return ix, nil
+}
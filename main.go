@@ -65,12 +65,27 @@ func setupRoutes(r *gin.Engine) {
 		// Asset routes
 		assets := api.Group("/assets")
 		{
-			assets.GET("/", handlers.GetAllAssets)                        // GET /api/assets
+			assets.GET("", handlers.GetAllAssets)                         // GET /api/assets
 			assets.POST("/mint", handlers.MintAsset)                      // POST /api/assets/mint
 			assets.GET("/:id", handlers.GetAssetByID)                     // GET /api/assets/:id
 			assets.PATCH("/:id/contract", handlers.UpdateContractAddress) // PATCH /api/assets/:id/contract
 			assets.GET("/:id/stats", handlers.GetAssetStats)              // GET /api/assets/:id/stats
 			assets.PATCH("/:id/staking", handlers.UpdateAssetStaking)     // PATCH /api/assets/:id/staking (for testing)
+			assets.POST("/:id/reindex", handlers.ReindexAsset)            // POST /api/assets/:id/reindex
+			assets.POST("/:id/halt", handlers.HaltAsset)                  // POST /api/assets/:id/halt
+			assets.POST("/:id/halt/lift", handlers.LiftAssetHalt)         // POST /api/assets/:id/halt/lift
+		}
+
+		// Mint job routes, for polling/recovering the async mints MintAsset
+		// enqueues onto the mintpool (see rwa-backend/mintpool)
+		mintJobs := api.Group("/mint-jobs")
+		{
+			mintJobs.GET("/:id", handlers.GetMintJob)          // GET /api/mint-jobs/:id
+			mintJobs.POST("/:id/retry", handlers.RetryMintJob) // POST /api/mint-jobs/:id/retry
 		}
 	}
+
+	// JSON-RPC 2.0 surface mirroring every REST route above, for services
+	// that want a typed client (see rwaclient) instead of ad-hoc HTTP.
+	r.POST("/rpc/v1", handlers.NewRPCHandler(r))
 }
@@ -0,0 +1,38 @@
+package mintpool
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// MintDraft is the canonical, hashable form of a mint request. Field order
+// here is what DeriveID hashes over, so it must never change without also
+// changing how existing in-flight job IDs are computed.
+type MintDraft struct {
+	Name               string  `json:"name"`
+	Symbol             string  `json:"symbol"`
+	InstitutionName    string  `json:"institutionName"`
+	InstitutionAddress string  `json:"institutionAddress"`
+	Description        string  `json:"description"`
+	TotalSupply        int64   `json:"totalSupply"`
+	ExpectedYield      float64 `json:"expectedYield"`
+	PricePerRWA        float64 `json:"pricePerRWA"`
+	Blockchain         string  `json:"blockchain"`
+	NetworkVersion     string  `json:"networkVersion"`
+	DocumentsURI       string  `json:"documentsURI"`
+	ImageURI           string  `json:"imageURI"`
+}
+
+// DeriveID computes a content-addressed job/asset ID from draft, so
+// submitting the same mint request twice (e.g. a client retrying after a
+// timeout) lands on the same job instead of minting a duplicate asset.
+func DeriveID(draft MintDraft) (string, error) {
+	canonical, err := json.Marshal(draft)
+	if err != nil {
+		return "", fmt.Errorf("canonicalize mint draft: %w", err)
+	}
+	sum := sha256.Sum256(canonical)
+	return fmt.Sprintf("asset_%s", hex.EncodeToString(sum[:])[:24]), nil
+}
@@ -0,0 +1,66 @@
+package mintpool
+
+import "testing"
+
+func sampleDraft() MintDraft {
+	return MintDraft{
+		Name:               "Downtown Office Tower",
+		Symbol:             "DOT",
+		InstitutionName:    "Acme Capital",
+		InstitutionAddress: "0xabc",
+		Description:        "A tokenized office building",
+		TotalSupply:        1_000_000,
+		ExpectedYield:      5.5,
+		PricePerRWA:        1.0,
+		Blockchain:         "Lisk",
+		NetworkVersion:     "mainnet",
+		DocumentsURI:       "ipfs://docs",
+		ImageURI:           "ipfs://image",
+	}
+}
+
+func TestDeriveIDIsDeterministic(t *testing.T) {
+	draft := sampleDraft()
+
+	id1, err := DeriveID(draft)
+	if err != nil {
+		t.Fatalf("DeriveID: %v", err)
+	}
+	id2, err := DeriveID(draft)
+	if err != nil {
+		t.Fatalf("DeriveID: %v", err)
+	}
+
+	if id1 != id2 {
+		t.Errorf("DeriveID(draft) = %s, then %s; want identical IDs for identical drafts", id1, id2)
+	}
+}
+
+func TestDeriveIDHasAssetPrefix(t *testing.T) {
+	id, err := DeriveID(sampleDraft())
+	if err != nil {
+		t.Fatalf("DeriveID: %v", err)
+	}
+	if len(id) < len("asset_") || id[:len("asset_")] != "asset_" {
+		t.Errorf("DeriveID() = %q, want it prefixed with \"asset_\"", id)
+	}
+}
+
+func TestDeriveIDDiffersForDifferentDrafts(t *testing.T) {
+	a := sampleDraft()
+	b := sampleDraft()
+	b.TotalSupply = a.TotalSupply + 1
+
+	idA, err := DeriveID(a)
+	if err != nil {
+		t.Fatalf("DeriveID(a): %v", err)
+	}
+	idB, err := DeriveID(b)
+	if err != nil {
+		t.Fatalf("DeriveID(b): %v", err)
+	}
+
+	if idA == idB {
+		t.Errorf("DeriveID gave the same ID %s for two different drafts", idA)
+	}
+}
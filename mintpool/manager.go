@@ -0,0 +1,21 @@
+package mintpool
+
+import (
+	"rwa-backend/database"
+	"sync"
+)
+
+var (
+	poolOnce sync.Once
+	pool     *Pool
+)
+
+// GetPool returns the process-wide mint job pool, lazily created against
+// database.GetDB() the same way indexer.GetManager() defers dialing RPC
+// until it's actually needed.
+func GetPool() *Pool {
+	poolOnce.Do(func() {
+		pool = NewPool(database.GetDB(), defaultWorkers)
+	})
+	return pool
+}
@@ -0,0 +1,288 @@
+// Package mintpool moves contract deployment out of the request/response
+// cycle: MintAsset enqueues a MintJob and returns immediately, and a small
+// worker pool signs, broadcasts and then watches the deployment
+// transaction through confirmations before flipping the asset's
+// ContractAddress, in the spirit of a transaction-pool mempool (bytom,
+// lotus) rather than a synchronous RPC call.
+package mintpool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"rwa-backend/chains"
+	"rwa-backend/database"
+	"rwa-backend/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// requiredConfirmations is how many blocks must be built on top of a
+// deployment transaction before its job is considered finalized.
+const requiredConfirmations = 3
+
+// confirmationPollInterval is how often a job in submitted/mined/confirmed
+// state re-checks its transaction's confirmation depth.
+const confirmationPollInterval = 5 * time.Second
+
+// defaultWorkers is how many jobs can be processed concurrently.
+const defaultWorkers = 4
+
+// Pool is a persistent queue of MintJobs backed by the MintJob table, so
+// in-flight jobs survive a process restart: NewPool requeues anything left
+// pending or submitted (orphaned mid-flight by the previous process dying),
+// and an operator calls Retry to resume a job that ran to completion and
+// failed.
+type Pool struct {
+	db    *gorm.DB
+	queue chan string
+}
+
+// NewPool returns a Pool backed by db, requeues any job left pending or
+// submitted by a previous process that didn't shut down cleanly, and starts
+// worker goroutines to drain the queue.
+func NewPool(db *gorm.DB, workers int) *Pool {
+	p := &Pool{db: db, queue: make(chan string, 256)}
+
+	var orphaned []models.MintJob
+	if err := db.Where("state IN ?", []string{models.MintJobPending, models.MintJobSubmitted}).Find(&orphaned).Error; err != nil {
+		log.Printf("⚠️  mintpool: failed to scan for orphaned jobs: %v", err)
+	}
+	for _, job := range orphaned {
+		p.enqueue(job.ID)
+	}
+
+	for i := 0; i < workers; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+func (p *Pool) worker() {
+	for jobID := range p.queue {
+		p.runJob(context.Background(), jobID)
+	}
+}
+
+// enqueue schedules jobID for processing without blocking the caller even
+// if the queue is momentarily full.
+func (p *Pool) enqueue(jobID string) {
+	select {
+	case p.queue <- jobID:
+	default:
+		go func() { p.queue <- jobID }()
+	}
+}
+
+// Submit derives a content-addressed ID for draft and enqueues a new
+// MintJob, or returns the existing job if this exact draft was already
+// submitted, so a client retrying after a timeout can't mint a duplicate
+// asset.
+func (p *Pool) Submit(ctx context.Context, draft MintDraft) (*models.MintJob, error) {
+	id, err := DeriveID(draft)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing models.MintJob
+	err = p.db.WithContext(ctx).First(&existing, "id = ?", id).Error
+	if err == nil {
+		return &existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(draft)
+	if err != nil {
+		return nil, fmt.Errorf("canonicalize mint draft: %w", err)
+	}
+
+	job := models.MintJob{
+		ID:             id,
+		AssetDraft:     string(encoded),
+		Blockchain:     draft.Blockchain,
+		NetworkVersion: draft.NetworkVersion,
+		State:          models.MintJobPending,
+	}
+	if err := p.db.WithContext(ctx).Create(&job).Error; err != nil {
+		return nil, err
+	}
+
+	p.enqueue(job.ID)
+	return &job, nil
+}
+
+// Get returns the MintJob with the given ID, for GET /api/mint-jobs/:id.
+func (p *Pool) Get(ctx context.Context, id string) (*models.MintJob, error) {
+	var job models.MintJob
+	if err := p.db.WithContext(ctx).First(&job, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Retry re-queues a job that ended in the failed state, for
+// POST /api/mint-jobs/:id/retry. Jobs in any other state are already
+// pending, in flight, or done, so retrying them would just race the
+// worker already handling them.
+func (p *Pool) Retry(ctx context.Context, id string) (*models.MintJob, error) {
+	var job models.MintJob
+	if err := p.db.WithContext(ctx).First(&job, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	if job.State != models.MintJobFailed {
+		return nil, fmt.Errorf("mint job %s is not in a failed state (current: %s)", id, job.State)
+	}
+
+	job.State = models.MintJobPending
+	job.LastError = ""
+	if err := p.db.WithContext(ctx).Save(&job).Error; err != nil {
+		return nil, err
+	}
+
+	p.enqueue(job.ID)
+	return &job, nil
+}
+
+// runJob drives jobID through as much of pending -> submitted -> mined ->
+// confirmed -> finalized as it can in one call, blocking on
+// confirmationPollInterval ticks while it waits for the chain.
+func (p *Pool) runJob(ctx context.Context, jobID string) {
+	var job models.MintJob
+	if err := p.db.First(&job, "id = ?", jobID).Error; err != nil {
+		log.Printf("⚠️  mintpool: could not load job %s: %v", jobID, err)
+		return
+	}
+
+	backend, err := chains.Get(job.Blockchain, job.NetworkVersion)
+	if err != nil {
+		p.fail(&job, err)
+		return
+	}
+
+	if job.State == models.MintJobPending {
+		if err := p.submit(ctx, &job, backend); err != nil {
+			p.fail(&job, err)
+			return
+		}
+	}
+
+	confirmer, ok := backend.(chains.Confirmer)
+	if !ok {
+		// This backend has no notion of confirmation depth (e.g. the
+		// in-memory devnet); treat the deployment as final as soon as
+		// it's been broadcast.
+		p.finalize(ctx, &job)
+		return
+	}
+
+	p.watchConfirmations(ctx, &job, confirmer)
+}
+
+func (p *Pool) submit(ctx context.Context, job *models.MintJob, backend chains.TokenBackend) error {
+	var draft MintDraft
+	if err := json.Unmarshal([]byte(job.AssetDraft), &draft); err != nil {
+		return fmt.Errorf("decode mint draft: %w", err)
+	}
+
+	job.Attempts++
+	result, err := backend.Deploy(ctx, chains.DeployRequest{
+		AssetID:     job.ID,
+		Name:        draft.Name,
+		Symbol:      draft.Symbol,
+		TotalSupply: big.NewInt(draft.TotalSupply),
+	})
+	if err != nil {
+		return err
+	}
+
+	job.TxHash = result.TxHash
+	job.ContractAddress = result.ContractAddress
+	job.DeployBlock = result.DeployBlock
+	job.State = models.MintJobSubmitted
+	return p.db.Save(job).Error
+}
+
+// watchConfirmations polls confirmer until job's transaction reaches
+// requiredConfirmations (finalizing it) or ctx is cancelled (e.g. process
+// shutdown; the job is simply picked up again from its current state the
+// next time an operator retries or the process restarts and resubmits).
+func (p *Pool) watchConfirmations(ctx context.Context, job *models.MintJob, confirmer chains.Confirmer) {
+	ticker := time.NewTicker(confirmationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, confirmations, err := confirmer.Confirmations(ctx, job.TxHash)
+		if err != nil {
+			// Not mined yet, or a transient RPC error; keep polling.
+			continue
+		}
+
+		job.Confirmations = confirmations
+		switch {
+		case confirmations == 0:
+			job.State = models.MintJobMined
+		case confirmations < requiredConfirmations:
+			job.State = models.MintJobConfirmed
+		default:
+			p.finalize(ctx, job)
+			return
+		}
+		if err := p.db.Save(job).Error; err != nil {
+			log.Printf("⚠️  mintpool: failed to save job %s: %v", job.ID, err)
+		}
+	}
+}
+
+// finalize marks job finalized and flips the corresponding Asset's
+// ContractAddress/TxHash, recording a Deployment the same way the old
+// synchronous MintAsset did.
+func (p *Pool) finalize(ctx context.Context, job *models.MintJob) {
+	job.State = models.MintJobFinalized
+	if err := p.db.Save(job).Error; err != nil {
+		log.Printf("⚠️  mintpool: failed to save job %s: %v", job.ID, err)
+	}
+
+	asset, err := database.GetRepository().GetAsset(ctx, job.ID)
+	if err != nil {
+		log.Printf("⚠️  mintpool: job %s finalized but its asset is missing: %v", job.ID, err)
+		return
+	}
+	asset.ContractAddress = job.ContractAddress
+	asset.TxHash = job.TxHash
+	if err := database.GetRepository().UpdateAsset(ctx, asset); err != nil {
+		log.Printf("⚠️  mintpool: failed to update asset %s after mint: %v", job.ID, err)
+		return
+	}
+
+	deployment := models.Deployment{
+		AssetID:         job.ID,
+		Blockchain:      job.Blockchain,
+		NetworkVersion:  job.NetworkVersion,
+		ContractAddress: job.ContractAddress,
+		DeployBlock:     job.DeployBlock,
+		TxHash:          job.TxHash,
+	}
+	if err := p.db.Create(&deployment).Error; err != nil {
+		log.Printf("⚠️  mintpool: failed to record deployment for %s: %v", job.ID, err)
+	}
+}
+
+func (p *Pool) fail(job *models.MintJob, cause error) {
+	job.State = models.MintJobFailed
+	job.LastError = cause.Error()
+	if err := p.db.Save(job).Error; err != nil {
+		log.Printf("⚠️  mintpool: failed to save job %s: %v", job.ID, err)
+	}
+}
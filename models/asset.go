@@ -22,11 +22,16 @@ type Asset struct {
 	CreatedAt          time.Time `json:"createdAt" gorm:"autoCreateTime"`
 	UpdatedAt          time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
 	Blockchain         string    `json:"blockchain" gorm:"default:'Lisk'"`
+	NetworkVersion     string    `json:"networkVersion" gorm:"default:'mainnet'"`
 	ContractAddress    string    `json:"contractAddress"`
 	TxHash             string    `json:"txHash"`
 	DocumentsURI       string    `json:"documentsURI"`
 	ImageURI           string    `json:"imageURI"`
 
+	// Deployments tracks every chain this asset has been minted on, so the
+	// same RWA can exist across multiple backends at once.
+	Deployments []Deployment `json:"deployments" gorm:"-"`
+
 	// Computed fields for frontend compatibility (not stored in DB)
 	Documents  []Document `json:"documents" gorm:"-"`
 	TopStakers []Staker   `json:"topStakers" gorm:"-"`
@@ -45,6 +50,12 @@ type AssetResponse struct {
 	TotalValue       float64 `json:"totalValue"`
 	StakedValue      float64 `json:"stakedValue"`
 	AvailableValue   float64 `json:"availableValue"`
+
+	// Governance halt state, populated by the caller (see
+	// handlers.attachHaltInfo) since it requires knowing the current
+	// indexed block.
+	IsHalted bool       `json:"isHalted"`
+	HaltInfo *AssetHalt `json:"haltInfo,omitempty"`
 }
 
 // Document represents supporting documentation
@@ -71,6 +82,8 @@ type MintRequest struct {
 	TotalSupply        string `json:"totalSupply" binding:"required"`
 	ExpectedYield      string `json:"expectedYield" binding:"required"`
 	PricePerRWA        string `json:"pricePerRWA"` // Optional field, defaults if not provided
+	Blockchain         string `json:"blockchain"`     // Optional, defaults to "Lisk"
+	NetworkVersion     string `json:"networkVersion"` // Optional, defaults to "mainnet"
 	ContractAddress    string `json:"contractAddress"`
 	TxHash             string `json:"txHash"`
 	DocumentsURI       string `json:"documentsURI"`
@@ -81,6 +94,10 @@ type MintRequest struct {
 type UpdateContractRequest struct {
 	ContractAddress string `json:"contractAddress" binding:"required"`
 	TxHash          string `json:"txHash"`
+	Blockchain      string `json:"blockchain"`     // Optional, defaults to the asset's existing chain
+	NetworkVersion  string `json:"networkVersion"` // Optional, defaults to the asset's existing network
+	ABIVersion      string `json:"abiVersion"`     // Optional, defaults to "v1"
+	DeployBlock     uint64 `json:"deployBlock"`    // Optional, the block the contract was deployed at
 }
 
 // TokenStats represents on-chain token statistics
@@ -135,8 +152,9 @@ func (a *Asset) ToAssetResponse() AssetResponse {
 	}
 }
 
-// BeforeCreate adds computed fields before returning to frontend
-func (a *Asset) AfterFind(tx *gorm.DB) error {
+// ApplyComputedDefaults fills in the fields that every Repository backend
+// must populate after loading an Asset, independent of how it was stored.
+func (a *Asset) ApplyComputedDefaults() {
 	// Add default document if DocumentsURI exists
 	if a.DocumentsURI != "" {
 		a.Documents = []Document{
@@ -150,30 +168,24 @@ func (a *Asset) AfterFind(tx *gorm.DB) error {
 		a.Documents = []Document{}
 	}
 
-	// Initialize mock stakers data for demonstration
-	if a.StakedAmount > 0 {
-		// Create mock staker data (in production, this would come from blockchain)
-		totalStaked := float64(a.StakedAmount)
-		a.TopStakers = []Staker{
-			{
-				Address:    "0x1234...5678",
-				Amount:     totalStaked * 0.4,
-				Percentage: 40.0,
-			},
-			{
-				Address:    "0xabcd...efgh",
-				Amount:     totalStaked * 0.35,
-				Percentage: 35.0,
-			},
-			{
-				Address:    "0x9876...5432",
-				Amount:     totalStaked * 0.25,
-				Percentage: 25.0,
-			},
-		}
-	} else {
+	// TopStakers is populated by the caller from the indexer package once
+	// the asset's contract is deployed (see handlers.GetAssetStats); until
+	// then it's left empty rather than filled with placeholder data.
+	if a.TopStakers == nil {
 		a.TopStakers = []Staker{}
 	}
+	if a.Deployments == nil {
+		a.Deployments = []Deployment{}
+	}
+}
+
+// AfterFind adds computed fields before returning an Asset loaded through
+// GORM. CouchDB-backed repositories call ApplyComputedDefaults directly,
+// since Deployments (tracked in SQLite regardless of DB_DRIVER, see
+// InitDatabase) requires a GORM query this hook already has access to.
+func (a *Asset) AfterFind(tx *gorm.DB) error {
+	a.ApplyComputedDefaults()
 
-	return nil
+	// Load every chain this asset has been deployed on.
+	return tx.Where("asset_id = ?", a.ID).Order("created_at").Find(&a.Deployments).Error
 }
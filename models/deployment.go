@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Deployment records one on-chain deployment of an asset's token contract.
+// An Asset can carry several Deployments so the same RWA can be minted
+// across multiple chains (e.g. Lisk L2 and Ethereum) without losing track
+// of earlier ones.
+type Deployment struct {
+	ID              uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	AssetID         string    `json:"assetId" gorm:"index;not null"`
+	Blockchain      string    `json:"blockchain" gorm:"not null"`      // e.g. "Lisk", "Ethereum", "Devnet"
+	NetworkVersion  string    `json:"networkVersion"`                  // e.g. "mainnet", "sepolia", "local"
+	ContractAddress string    `json:"contractAddress"`
+	DeployBlock     uint64    `json:"deployBlock"`
+	ABIVersion      string    `json:"abiVersion" gorm:"default:'v1'"`
+	TxHash          string    `json:"txHash"`
+	CreatedAt       time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// AssetHalt is a governance-style freeze on an asset, submitted by its
+// issuer (the wallet at Asset.InstitutionAddress) to stop staking/transfer
+// writes starting at a future block. Mirrors the SetHaltBlock pattern:
+// the halt is scheduled rather than immediate, and lifting it requires a
+// second signed request rather than just deleting the row.
+type AssetHalt struct {
+	ID             uint       `json:"id" gorm:"primaryKey;autoIncrement"`
+	AssetID        string     `json:"assetId" gorm:"index;not null"`
+	EffectiveBlock uint64     `json:"effectiveBlock" gorm:"not null"`
+	Reason         string     `json:"reason"`
+	SignerAddress  string     `json:"signerAddress" gorm:"not null"`
+	Signature      string     `json:"signature" gorm:"not null"`
+	LiftedAt       *time.Time `json:"liftedAt"`
+	LiftSignature  string     `json:"liftSignature,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// IsActive reports whether this halt is currently in effect: it's been
+// reached (or passed) on-chain and nobody has lifted it yet.
+func (h *AssetHalt) IsActive(currentBlock uint64) bool {
+	return h.LiftedAt == nil && currentBlock >= h.EffectiveBlock
+}
+
+// HaltRequest is the payload for POST /api/assets/:id/halt.
+type HaltRequest struct {
+	Reason         string `json:"reason" binding:"required"`
+	EffectiveBlock uint64 `json:"effectiveBlock" binding:"required"`
+	Signature      string `json:"signature" binding:"required"`
+}
+
+// HaltLiftRequest is the payload for POST /api/assets/:id/halt/lift.
+type HaltLiftRequest struct {
+	Signature string `json:"signature" binding:"required"`
+}
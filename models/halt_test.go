@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAssetHaltIsActive(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		halt    AssetHalt
+		current uint64
+		want    bool
+	}{
+		{
+			name:    "effective block not yet reached",
+			halt:    AssetHalt{EffectiveBlock: 100},
+			current: 99,
+			want:    false,
+		},
+		{
+			name:    "effective block reached",
+			halt:    AssetHalt{EffectiveBlock: 100},
+			current: 100,
+			want:    true,
+		},
+		{
+			name:    "effective block passed",
+			halt:    AssetHalt{EffectiveBlock: 100},
+			current: 200,
+			want:    true,
+		},
+		{
+			name:    "lifted halt is never active, even past its effective block",
+			halt:    AssetHalt{EffectiveBlock: 100, LiftedAt: &now},
+			current: 200,
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.halt.IsActive(tt.current); got != tt.want {
+				t.Errorf("IsActive(%d) = %v, want %v", tt.current, got, tt.want)
+			}
+		})
+	}
+}
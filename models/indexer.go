@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// Holder represents a token holder's balance for a given contract, derived
+// entirely from indexed Transfer events (never written to directly by the
+// API layer).
+type Holder struct {
+	ID              uint      `json:"-" gorm:"primaryKey;autoIncrement"`
+	ContractAddress string    `json:"contractAddress" gorm:"uniqueIndex:idx_holder_contract_address;not null"`
+	Address         string    `json:"address" gorm:"uniqueIndex:idx_holder_contract_address;not null"`
+	Balance         string    `json:"balance" gorm:"not null;default:'0'"` // decimal string, stored as big.Int
+	UpdatedAtBlock  uint64    `json:"updatedAtBlock"`
+	UpdatedAt       time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// StakeEvent is a single stake/unstake log decoded from the staking
+// contract, keyed by (contract_address, block_number, log_index) so a
+// rescan can upsert without creating duplicates.
+type StakeEvent struct {
+	ID              uint      `json:"-" gorm:"primaryKey;autoIncrement"`
+	ContractAddress string    `json:"contractAddress" gorm:"uniqueIndex:idx_stake_event_log;not null"`
+	BlockNumber     uint64    `json:"blockNumber" gorm:"uniqueIndex:idx_stake_event_log;not null"`
+	LogIndex        uint      `json:"logIndex" gorm:"uniqueIndex:idx_stake_event_log;not null"`
+	StakerAddress   string    `json:"stakerAddress" gorm:"not null"`
+	Amount          string    `json:"amount" gorm:"not null"`
+	EventType       string    `json:"eventType"` // "stake" or "unstake"
+	TxHash          string    `json:"txHash"`
+	CreatedAt       time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// Transfer is a single ERC-20 Transfer log decoded from the token
+// contract, keyed the same way as StakeEvent.
+type Transfer struct {
+	ID              uint      `json:"-" gorm:"primaryKey;autoIncrement"`
+	ContractAddress string    `json:"contractAddress" gorm:"uniqueIndex:idx_transfer_log;not null"`
+	BlockNumber     uint64    `json:"blockNumber" gorm:"uniqueIndex:idx_transfer_log;not null"`
+	LogIndex        uint      `json:"logIndex" gorm:"uniqueIndex:idx_transfer_log;not null"`
+	From            string    `json:"from" gorm:"not null"`
+	To              string    `json:"to" gorm:"not null"`
+	Value           string    `json:"value" gorm:"not null"`
+	TxHash          string    `json:"txHash"`
+	CreatedAt       time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// IndexerCursor tracks per-contract indexing progress so the indexer can
+// resume after a restart and detect reorgs by comparing parent hashes.
+type IndexerCursor struct {
+	ContractAddress    string    `json:"contractAddress" gorm:"primaryKey"`
+	LastIndexedBlock   uint64    `json:"lastIndexedBlock"`
+	LastFinalizedBlock uint64    `json:"lastFinalizedBlock"`
+	LastBlockHash      string    `json:"lastBlockHash"`
+	UpdatedAt          time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
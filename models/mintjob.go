@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// MintJob states. A job moves pending -> submitted -> mined -> confirmed
+// -> finalized as its deployment transaction gains confirmations, or to
+// failed at any point before finalized; failed is the only state
+// mintpool.Retry accepts from.
+const (
+	MintJobPending   = "pending"
+	MintJobSubmitted = "submitted"
+	MintJobMined     = "mined"
+	MintJobConfirmed = "confirmed"
+	MintJobFinalized = "finalized"
+	MintJobFailed    = "failed"
+)
+
+// MintJob tracks one asynchronous mint request from submission through
+// on-chain finalization. Its ID is a content-addressed hash of the
+// canonical mint payload (see mintpool.DeriveID) rather than a timestamp,
+// so retrying an identical request is idempotent instead of minting a
+// duplicate asset, and it doubles as the resulting Asset's ID once the
+// job reaches mined.
+type MintJob struct {
+	ID              string    `json:"id" gorm:"primaryKey"`
+	AssetDraft      string    `json:"-" gorm:"type:text;not null"` // canonical JSON of the mint request that produced this job
+	Blockchain      string    `json:"blockchain"`
+	NetworkVersion  string    `json:"networkVersion"`
+	State           string    `json:"state"`
+	Attempts        int       `json:"attempts"`
+	Confirmations   uint64    `json:"confirmations"`
+	LastError       string    `json:"lastError,omitempty"`
+	TxHash          string    `json:"txHash,omitempty"`
+	ContractAddress string    `json:"contractAddress,omitempty"`
+	DeployBlock     uint64    `json:"deployBlock,omitempty"`
+	CreatedAt       time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}